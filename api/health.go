@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthCheck probes a single dependency of the service, returning
+// an error if it's not available.
+type HealthCheck func(ctx context.Context) error
+
+// HealthChecks maps component names to their probes.
+type HealthChecks map[string]HealthCheck
+
+const healthCheckTimeout = 5 * time.Second
+
+const (
+	HealthStatusOK   = "ok"
+	HealthStatusFail = "fail"
+)
+
+type HealthResponse struct {
+	Status     string                      `json:"status"`
+	Components map[string]*ComponentHealth `json:"components,omitempty"`
+}
+
+type ComponentHealth struct {
+	Status  string `json:"status"`
+	Latency string `json:"latency"`
+	Error   string `json:"error,omitempty"`
+}
+
+// handleHealthLive reports whether the process is up and serving. It deliberately doesn't
+// probe the dependencies, so an outage of those doesn't cause restarts of the service.
+func (s *httpServer) handleHealthLive() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, HealthResponse{
+			Status: HealthStatusOK,
+		})
+	}
+}
+
+// handleHealthReady probes all dependencies concurrently and reports per-component
+// status and latency. Responds with 503 if any of the components is failing.
+func (s *httpServer) handleHealthReady() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp := s.healthChecks.run(c.Request.Context())
+
+		if resp.Status != HealthStatusOK {
+			c.JSON(http.StatusServiceUnavailable, resp)
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+func (h HealthChecks) run(ctx context.Context) *HealthResponse {
+	resp := &HealthResponse{
+		Status:     HealthStatusOK,
+		Components: make(map[string]*ComponentHealth, len(h)),
+	}
+
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]*ComponentHealth, len(names))
+
+	wg := new(sync.WaitGroup)
+	for idx, name := range names {
+		wg.Add(1)
+
+		go func(idx int, check HealthCheck) {
+			defer wg.Done()
+
+			checkCtx, cancelFn := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancelFn()
+
+			ts := time.Now()
+			err := check(checkCtx)
+
+			result := &ComponentHealth{
+				Status:  HealthStatusOK,
+				Latency: time.Since(ts).String(),
+			}
+
+			if err != nil {
+				result.Status = HealthStatusFail
+				result.Error = err.Error()
+			}
+
+			results[idx] = result
+		}(idx, h[name])
+	}
+	wg.Wait()
+
+	for idx, name := range names {
+		resp.Components[name] = results[idx]
+
+		if results[idx].Status != HealthStatusOK {
+			resp.Status = HealthStatusFail
+		}
+	}
+
+	return resp
+}
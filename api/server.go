@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	log "github.com/xlab/suplog"
 
 	"github.com/InjectiveLabs/chainlink-injective/db/model"
 	"github.com/InjectiveLabs/chainlink-injective/metrics"
+	ocrconfig "github.com/InjectiveLabs/chainlink-injective/ocr2/config"
 )
 
 const (
@@ -21,6 +23,9 @@ type JobService interface {
 	StartJob(jobID string, spec *model.JobSpec) error
 	RunJob(jobID, result string) error
 	StopJob(jobID string) error
+	ListJobs(ctx context.Context) []*model.JobStatus
+	GetJob(ctx context.Context, jobID string) (*model.JobStatus, bool)
+	InspectFeedConfig(ctx context.Context, feedID string) (*ocrconfig.FeedConfigInfo, error)
 }
 
 type AuthCredentials struct {
@@ -40,11 +45,14 @@ type httpServer struct {
 	svc     JobService
 	logger  log.Logger
 	svcTags metrics.Tags
+
+	healthChecks HealthChecks
 }
 
 func NewServer(
 	auth AuthCredentials,
 	svc JobService,
+	healthChecks HealthChecks,
 ) (HTTPServer, error) {
 	if len(auth.AccessKey) == 0 {
 		err := errors.New("mandatory acces key is not provided")
@@ -58,6 +66,8 @@ func NewServer(
 		router: gin.Default(),
 		svc:    svc,
 
+		healthChecks: healthChecks,
+
 		logger: log.WithFields(log.Fields{
 			"svc": "api_srv",
 		}),
@@ -66,13 +76,18 @@ func NewServer(
 		},
 	}
 
-	srv.router.GET("/health", handleShowHealth())
+	srv.router.GET("/health", srv.handleHealthLive())
+	srv.router.GET("/health/live", srv.handleHealthLive())
+	srv.router.GET("/health/ready", srv.handleHealthReady())
 	srv.router.POST("/runs", srv.handleJobRun())
 
 	privateGroup := srv.router.Group("/")
 	privateGroup.Use(authenticated(auth.AccessKey, auth.Secret))
+	privateGroup.GET("/jobs", srv.handleJobList())
+	privateGroup.GET("/jobs/:jobid", srv.handleJobShow())
 	privateGroup.POST("/jobs", srv.handleJobCreate())
 	privateGroup.DELETE("/jobs/:jobid", srv.handleJobStop())
+	privateGroup.GET("/feeds/config/*feedid", srv.handleFeedConfigShow())
 
 	return srv, nil
 }
@@ -201,6 +216,66 @@ func (s *httpServer) handleJobStop() gin.HandlerFunc {
 	}
 }
 
+type JobListResponse struct {
+	Jobs []*model.JobStatus `json:"jobs"`
+}
+
+func (s *httpServer) handleJobList() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		metrics.ReportFuncCall(s.svcTags)
+		doneFn := metrics.ReportFuncTiming(s.svcTags)
+		defer doneFn()
+
+		c.JSON(http.StatusOK, JobListResponse{
+			Jobs: s.svc.ListJobs(c.Request.Context()),
+		})
+	}
+}
+
+func (s *httpServer) handleJobShow() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		metrics.ReportFuncCall(s.svcTags)
+		doneFn := metrics.ReportFuncTiming(s.svcTags)
+		defer doneFn()
+
+		jobID := c.Param("jobid")
+
+		status, ok := s.svc.GetJob(c.Request.Context(), jobID)
+		if !ok {
+			c.JSON(http.StatusNotFound, nil)
+			return
+		}
+
+		c.JSON(http.StatusOK, status)
+	}
+}
+
+func (s *httpServer) handleFeedConfigShow() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		metrics.ReportFuncCall(s.svcTags)
+		doneFn := metrics.ReportFuncTiming(s.svcTags)
+		defer doneFn()
+
+		handlerLog := s.logger.WithField("handler", "handleFeedConfigShow")
+
+		// feed IDs contain slashes, e.g. LINK/USDC
+		feedID := strings.TrimPrefix(c.Param("feedid"), "/")
+
+		info, err := s.svc.InspectFeedConfig(c.Request.Context(), feedID)
+		if err != nil {
+			metrics.ReportFuncError(s.svcTags)
+			handlerLog.WithError(err).Errorln("failed to inspect feed config")
+			c.JSON(http.StatusInternalServerError, nil)
+			return
+		} else if info == nil {
+			c.JSON(http.StatusNotFound, nil)
+			return
+		}
+
+		c.JSON(http.StatusOK, info)
+	}
+}
+
 func authenticated(accessKey, secret string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		reqAccessKey := c.GetHeader(externalInitiatorAccessKeyHeader)
@@ -212,9 +287,3 @@ func authenticated(accessKey, secret string) gin.HandlerFunc {
 		}
 	}
 }
-
-func handleShowHealth() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		c.JSON(200, gin.H{"chainlink": true})
-	}
-}
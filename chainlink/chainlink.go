@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,6 +13,7 @@ import (
 
 type WebhookClient interface {
 	TriggerJob(jobID string) error
+	CheckHealth(ctx context.Context) error
 }
 
 type webhookClient struct {
@@ -69,3 +71,28 @@ func (c *webhookClient) TriggerJob(jobID string) error {
 
 	return nil
 }
+
+// CheckHealth queries the health endpoint of the Chainlink node.
+func (c *webhookClient) CheckHealth(ctx context.Context) error {
+	url := fmt.Sprintf("%s/health", c.nodeURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		err = errors.Wrap(err, "failed to create HTTP request")
+		return err
+	}
+
+	resp, err := c.c.Do(req)
+	if err != nil {
+		err = errors.Wrap(err, "Chainlink node is not reachable")
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = errors.Errorf("Chainlink node health check returned status %d", resp.StatusCode)
+		return err
+	}
+
+	return nil
+}
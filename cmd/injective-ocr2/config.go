@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	cryptorand "crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+	"github.com/InjectiveLabs/chainlink-injective/keys/ocrkey"
+	ocrconfig "github.com/InjectiveLabs/chainlink-injective/ocr2/config"
+)
+
+func configCmd(cmd *cli.Cmd) {
+	cmd.Command("build", "Build SetConfigProposal JSON from a YAML/JSON feed config spec", configBuild)
+	cmd.Command("submit", "Build SetConfigProposal from a YAML/JSON feed config spec and submit it to governance", configSubmit)
+	cmd.Command("inspect", "Fetch the feed config from chain, decode it and verify membership of local keys", configInspect)
+}
+
+// initConfigProposalOptions sets options common to config commands and returns
+// a func that builds the proposal from the spec file.
+func initConfigProposalOptions(cmd *cli.Cmd) func() (*chaintypes.SetConfigProposal, error) {
+	specFile := cmd.StringArg("SPEC_FILE", "", "Path to the feed config spec in YAML or JSON format")
+
+	title := cmd.String(cli.StringOpt{
+		Name:  "title",
+		Desc:  "Specify the proposal title, defaults to 'SetConfig Proposal for <feedId>'",
+		Value: "",
+	})
+
+	description := cmd.String(cli.StringOpt{
+		Name:  "description",
+		Desc:  "Specify the proposal description",
+		Value: "Grants transmitter/signer privileges and sets feed config",
+	})
+
+	return func() (*chaintypes.SetConfigProposal, error) {
+		spec, err := ocrconfig.LoadFeedConfigSpec(*specFile)
+		if err != nil {
+			return nil, err
+		}
+
+		feedConfig, err := ocrconfig.BuildFeedConfig(spec, cryptorand.Reader)
+		if err != nil {
+			return nil, err
+		}
+
+		proposal := &chaintypes.SetConfigProposal{
+			Title:       *title,
+			Description: *description,
+			Config:      feedConfig,
+		}
+
+		if len(proposal.Title) == 0 {
+			proposal.Title = fmt.Sprintf("SetConfig Proposal for %s", spec.FeedID)
+		}
+
+		if err := proposal.ValidateBasic(); err != nil {
+			err = errors.Wrap(err, "proposal is invalid")
+			return nil, err
+		}
+
+		return proposal, nil
+	}
+}
+
+func configBuild(c *cli.Cmd) {
+	buildProposal := initConfigProposalOptions(c)
+
+	outFile := c.String(cli.StringOpt{
+		Name:  "o out",
+		Desc:  "Write the proposal JSON into file instead of stdout",
+		Value: "",
+	})
+
+	c.Action = func() {
+		proposal, err := buildProposal()
+		orFatal(err)
+
+		proposalJSON, err := codec.ProtoMarshalJSON(proposal, nil)
+		orFatal(errors.Wrap(err, "failed to marshal proposal JSON"))
+
+		if len(*outFile) == 0 {
+			fmt.Println(string(proposalJSON))
+			return
+		}
+
+		err = ioutil.WriteFile(*outFile, proposalJSON, 0644)
+		orFatal(errors.Wrap(err, "failed to write proposal JSON"))
+
+		log.WithFields(log.Fields{
+			"feedId": proposal.Config.ModuleParams.FeedId,
+			"file":   *outFile,
+		}).Infoln("Saved SetConfigProposal")
+	}
+}
+
+func configSubmit(c *cli.Cmd) {
+	opts := initCosmosTxCmdOptions(c)
+	buildProposal := initConfigProposalOptions(c)
+
+	deposit := c.String(cli.StringOpt{
+		Name:  "deposit",
+		Desc:  "Specify the initial proposal deposit as sdk.Coins",
+		Value: "",
+	})
+
+	c.Action = func() {
+		opts.broadcastMsg(func(sender sdk.AccAddress) (sdk.Msg, error) {
+			proposal, err := buildProposal()
+			if err != nil {
+				return nil, err
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(*deposit)
+			if err != nil {
+				err = errors.Wrap(err, "failed to parse deposit")
+				return nil, err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, depositCoins, sender)
+			if err != nil {
+				err = errors.Wrap(err, "failed to create proposal msg")
+				return nil, err
+			}
+
+			return msg, nil
+		})
+	}
+}
+
+func configInspect(c *cli.Cmd) {
+	var (
+		cosmosChainID   *string
+		cosmosGRPC      *string
+		tendermintRPC   *string
+		cosmosGasPrices *string
+
+		ocrKeyringDir    *string
+		ocrKeyID         *string
+		ocrKeyPassphrase *string
+		ocrPrivKey       *string
+
+		p2pKeyringDir    *string
+		p2pPeerID        *string
+		p2pKeyPassphrase *string
+		p2pPrivKey       *string
+	)
+
+	initCosmosOptions(
+		c,
+		&cosmosChainID,
+		&cosmosGRPC,
+		&tendermintRPC,
+		&cosmosGasPrices,
+	)
+
+	initOCRKeyOptions(
+		c,
+		&ocrKeyringDir,
+		&ocrKeyID,
+		&ocrKeyPassphrase,
+		&ocrPrivKey,
+	)
+
+	initP2PKeyOptions(
+		c,
+		&p2pKeyringDir,
+		&p2pPeerID,
+		&p2pKeyPassphrase,
+		&p2pPrivKey,
+	)
+
+	signer := c.String(cli.StringOpt{
+		Name:  "signer",
+		Desc:  "Specify the on-chain signer address of the local oracle to check",
+		Value: "",
+	})
+
+	transmitters := c.Strings(cli.StringsOpt{
+		Name:  "transmitters",
+		Desc:  "Specify transmitter addresses of the local oracle to check",
+		Value: []string{},
+	})
+
+	feedID := c.StringArg("FEED_ID", "", "Specify the feed ID")
+
+	c.Action = func() {
+		conn, err := grpcDialEndpoint(*cosmosGRPC)
+		orFatal(err)
+		defer conn.Close()
+
+		queryClient := chaintypes.NewQueryClient(conn)
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), cosmosQueryTimeout)
+		defer cancelFn()
+
+		resp, err := queryClient.FeedConfig(ctx, &chaintypes.QueryFeedConfigRequest{
+			FeedId: *feedID,
+		})
+		orFatal(errors.Wrap(err, "failed to query feed config"))
+
+		if resp.FeedConfig == nil {
+			log.Fatalln("feed config not found:", *feedID)
+		}
+
+		local := &ocrconfig.LocalOracle{
+			Signer:       *signer,
+			Transmitters: *transmitters,
+		}
+
+		if len(*ocrKeyID) > 0 || len(*ocrPrivKey) > 0 {
+			_, ocrKey, err := initOCRKey(ocrKeyringDir, ocrKeyID, ocrKeyPassphrase, ocrPrivKey)
+			orFatal(errors.Wrap(err, "failed to load OCR2 key"))
+
+			local.OffchainKeyring = ocrkey.NewOCR2KeyWrapper(ocrKey)
+		}
+
+		if len(*p2pPeerID) > 0 || len(*p2pPrivKey) > 0 {
+			peerID, _, err := initP2PKey(p2pKeyringDir, p2pPeerID, p2pKeyPassphrase, p2pPrivKey)
+			orFatal(errors.Wrap(err, "failed to load P2P Peer key"))
+
+			local.PeerID = peer.ID(peerID).Pretty()
+		}
+
+		info, err := ocrconfig.InspectFeedConfig(resp.FeedConfig, local)
+		orFatal(err)
+
+		v, _ := json.MarshalIndent(info, "", "  ")
+		fmt.Println(string(v))
+
+		for _, warning := range info.Membership.Warnings {
+			log.Warningln(warning)
+		}
+	}
+}
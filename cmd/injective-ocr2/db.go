@@ -0,0 +1,338 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"time"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/chainlink-injective/api"
+	"github.com/InjectiveLabs/chainlink-injective/db"
+	"github.com/InjectiveLabs/chainlink-injective/db/dbconn"
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+const (
+	dbMigrateTimeout = 10 * time.Minute
+	dbArchiveTimeout = 10 * time.Minute
+)
+
+func dbCmd(cmd *cli.Cmd) {
+	cmd.Command("migrate", "Apply pending schema migrations of MongoDB", dbMigrate)
+	cmd.Command("status", "List schema migrations of MongoDB and whether they are applied", dbStatus)
+	cmd.Command("export", "Export jobs and OCR state into a JSON archive", dbExport)
+	cmd.Command("import", "Import jobs and OCR state from a JSON archive", dbImport)
+}
+
+func dbMigrate(c *cli.Cmd) {
+	openMongo := initMongoMigrationOptions(c)
+
+	c.Action = func() {
+		dbConn := openMongo()
+		defer dbConn.Close()
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), dbMigrateTimeout)
+		defer cancelFn()
+
+		applied, err := db.MigrateMongo(ctx, dbConn)
+		for _, migration := range applied {
+			log.WithFields(log.Fields{
+				"version":     migration.Version,
+				"description": migration.Description,
+			}).Infoln("Applied migration")
+		}
+		orFatal(err)
+
+		if len(applied) == 0 {
+			log.Infoln("Schema is up to date")
+		}
+	}
+}
+
+func dbStatus(c *cli.Cmd) {
+	openMongo := initMongoMigrationOptions(c)
+
+	c.Action = func() {
+		dbConn := openMongo()
+		defer dbConn.Close()
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancelFn()
+
+		status, err := db.MongoMigrationStatus(ctx, dbConn)
+		orFatal(err)
+
+		for _, migration := range status {
+			appliedAt := "pending"
+			if migration.AppliedAt != nil {
+				appliedAt = migration.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Printf("%d\t%s\t%s\n", migration.Version, appliedAt, migration.Description)
+		}
+	}
+}
+
+func dbExport(c *cli.Cmd) {
+	openDB := initStorageOptions(c)
+
+	outFile := c.String(cli.StringOpt{
+		Name:  "o out",
+		Desc:  "Write the archive into file instead of stdout",
+		Value: "",
+	})
+
+	c.Action = func() {
+		dbStorage, closeFn := openDB()
+		defer closeFn()
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), dbArchiveTimeout)
+		defer cancelFn()
+
+		archive, err := db.ExportArchive(ctx, dbStorage)
+		orFatal(err)
+
+		archiveJSON, err := json.MarshalIndent(archive, "", "  ")
+		orFatal(errors.Wrap(err, "failed to marshal archive JSON"))
+
+		if len(*outFile) == 0 {
+			fmt.Println(string(archiveJSON))
+			return
+		}
+
+		err = ioutil.WriteFile(*outFile, archiveJSON, 0600)
+		orFatal(errors.Wrap(err, "failed to write archive JSON"))
+
+		log.WithFields(log.Fields{
+			"jobs": len(archive.Jobs),
+			"file": *outFile,
+		}).Infoln("Exported oracle state")
+	}
+}
+
+func dbImport(c *cli.Cmd) {
+	openDB := initStorageOptions(c)
+
+	force := c.Bool(cli.BoolOpt{
+		Name:  "force",
+		Desc:  "Import even if the DB already has jobs, records from the archive overwrite existing ones",
+		Value: false,
+	})
+
+	inFile := c.StringArg("FILE", "", "Path to the archive JSON written by db export")
+
+	c.Action = func() {
+		archiveJSON, err := ioutil.ReadFile(*inFile)
+		orFatal(errors.Wrap(err, "failed to read archive JSON"))
+
+		var archive model.Archive
+		err = json.Unmarshal(archiveJSON, &archive)
+		orFatal(errors.Wrap(err, "failed to unmarshal archive JSON"))
+
+		dbStorage, closeFn := openDB()
+		defer closeFn()
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), dbArchiveTimeout)
+		defer cancelFn()
+
+		if !*force {
+			jobs, err := dbStorage.ListJobs(ctx, &model.Cursor{Limit: 1})
+			orFatal(err)
+
+			if len(jobs) > 0 {
+				log.Fatalln("DB already has jobs, use --force to import anyway")
+			}
+		}
+
+		err = db.ImportArchive(ctx, dbStorage, &archive)
+		orFatal(err)
+
+		log.WithFields(log.Fields{
+			"jobs": len(archive.Jobs),
+			"db":   dbStorage.String(),
+		}).Infoln("Imported oracle state")
+	}
+}
+
+// initStorageOptions sets DB options and returns a func that opens the configured storage.
+func initStorageOptions(c *cli.Cmd) func() (db.Storage, func()) {
+	var (
+		dbEngine          *string
+		dbMongoConnection *string
+		dbMongoDBName     *string
+		dbPostgresURL     *string
+		dbBoltPath        *string
+	)
+
+	initDBOptions(
+		c,
+		&dbEngine,
+		&dbMongoConnection,
+		&dbMongoDBName,
+		&dbPostgresURL,
+		&dbBoltPath,
+	)
+
+	return func() (db.Storage, func()) {
+		dbStorage, _, closeFn := openStorage(
+			*dbEngine,
+			*dbMongoConnection,
+			*dbMongoDBName,
+			*dbPostgresURL,
+			*dbBoltPath,
+		)
+
+		return dbStorage, closeFn
+	}
+}
+
+// openStorage opens the storage of DB engine, returns its health check if there is any,
+// and a func that closes it.
+func openStorage(
+	engine string,
+	mongoConnection string,
+	mongoDBName string,
+	postgresURL string,
+	boltPath string,
+) (db.Storage, api.HealthCheck, func()) {
+	switch engine {
+	case "postgres":
+		// Initialize PostgreSQL connection
+		//
+
+		log.Infoln("Connecting to DB using Gorm (PostgreSQL)")
+
+		pgURL, err := url.ParseRequestURI(postgresURL)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to parse PostgreSQL URL: %s", postgresURL)
+		}
+
+		dbGorm, err := db.NewExternalPostgres(pgURL)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect to PostgreSQL instance")
+		}
+
+		return dbGorm, sqlHealthCheck(dbGorm), dbGorm.Close
+
+	case "mongo":
+		// Setup MongoDB database connection
+		//
+
+		dbConn := connectMongo(mongoConnection, mongoDBName)
+
+		migrateCtx, cancelFn := context.WithTimeout(context.Background(), dbMigrateTimeout)
+		applied, err := db.MigrateMongo(migrateCtx, dbConn)
+		cancelFn()
+
+		for _, migration := range applied {
+			log.WithFields(log.Fields{
+				"version":     migration.Version,
+				"description": migration.Description,
+			}).Infoln("Applied MongoDB migration")
+		}
+
+		if err != nil {
+			log.WithError(err).Fatalln("failed to migrate MongoDB schema")
+		}
+
+		dbSvc, err := db.NewDBService(dbConn)
+		if err != nil {
+			err = errors.Wrap(err, "failed to init DB service")
+			log.Fatalln(err)
+		}
+
+		log.Infoln("Successfully connected to MongoDB")
+
+		closeFn := func() {
+			dbSvc.Close()
+
+			if err := dbConn.Close(); err != nil {
+				log.WithError(err).Warningln("failed to close MongoDB connection")
+			}
+		}
+
+		return dbSvc, dbConn.TestConn, closeFn
+
+	case "bolt":
+		// Open embedded DB file, no external DB needed
+		//
+
+		dbBolt, err := db.NewBoltDBService(boltPath)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to open embedded DB")
+		}
+
+		log.WithField("path", dbBolt.Path()).Infoln("Opened embedded DB")
+
+		return dbBolt, nil, dbBolt.Close
+
+	case "memory":
+		// Keep all data in memory, it's lost on exit
+		//
+
+		log.Warningln("Using in-memory DB, jobs and OCR state will be lost on exit")
+
+		dbMemory := db.NewMemoryDBService()
+
+		return dbMemory, nil, dbMemory.Close
+
+	default:
+		log.Fatalln("Unsupported DB engine:", engine)
+		return nil, nil, nil
+	}
+}
+
+// initMongoMigrationOptions sets DB options and returns a func that connects to MongoDB.
+// Other engines don't need migrations: PostgreSQL tables are migrated on start.
+func initMongoMigrationOptions(c *cli.Cmd) func() dbconn.Conn {
+	var (
+		dbEngine          *string
+		dbMongoConnection *string
+		dbMongoDBName     *string
+		dbPostgresURL     *string
+		dbBoltPath        *string
+	)
+
+	initDBOptions(
+		c,
+		&dbEngine,
+		&dbMongoConnection,
+		&dbMongoDBName,
+		&dbPostgresURL,
+		&dbBoltPath,
+	)
+
+	return func() dbconn.Conn {
+		if *dbEngine != "mongo" {
+			log.Fatalln("Schema migrations are managed only for mongo engine, got:", *dbEngine)
+		}
+
+		return connectMongo(*dbMongoConnection, *dbMongoDBName)
+	}
+}
+
+// connectMongo connects to MongoDB and tests the connection.
+func connectMongo(connection, dbName string) dbconn.Conn {
+	log.Infoln("Connecting to MongoDB")
+
+	dbConnContext, cancelFn := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancelFn()
+
+	dbConn, err := dbconn.NewMongoConn(dbConnContext, &dbconn.MongoConfig{
+		Connection: connection,
+		Database:   dbName,
+	})
+	if err != nil {
+		log.WithError(err).Fatalln("failed to init MongoDB client")
+	} else if err := dbConn.TestConn(dbConnContext); err != nil {
+		log.Fatalln(errors.Wrap(err, "failed to connect to MongoDB"))
+	}
+
+	return dbConn
+}
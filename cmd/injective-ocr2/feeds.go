@@ -0,0 +1,319 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	log "github.com/xlab/suplog"
+
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+const cosmosQueryTimeout = 30 * time.Second
+
+// cosmosTxCmdOptions are the common options of commands that sign and broadcast Cosmos Txs.
+type cosmosTxCmdOptions struct {
+	cosmosChainID   *string
+	cosmosGRPC      *string
+	tendermintRPC   *string
+	cosmosGasPrices *string
+
+	cosmosKeyringDir     *string
+	cosmosKeyringAppName *string
+	cosmosKeyringBackend *string
+	cosmosKeyFrom        *string
+	cosmosKeyPassphrase  *string
+	cosmosPrivKey        *string
+	cosmosUseLedger      *bool
+}
+
+func initCosmosTxCmdOptions(cmd *cli.Cmd) *cosmosTxCmdOptions {
+	opts := new(cosmosTxCmdOptions)
+
+	initCosmosOptions(
+		cmd,
+		&opts.cosmosChainID,
+		&opts.cosmosGRPC,
+		&opts.tendermintRPC,
+		&opts.cosmosGasPrices,
+	)
+
+	initCosmosKeyOptions(
+		cmd,
+		&opts.cosmosKeyringDir,
+		&opts.cosmosKeyringAppName,
+		&opts.cosmosKeyringBackend,
+		&opts.cosmosKeyFrom,
+		&opts.cosmosKeyPassphrase,
+		&opts.cosmosPrivKey,
+		&opts.cosmosUseLedger,
+	)
+
+	return opts
+}
+
+// cosmosClient inits a client that signs Txs with the configured Cosmos keyring.
+func (opts *cosmosTxCmdOptions) cosmosClient() (chainclient.CosmosClient, error) {
+	senderAddress, cosmosKeyring, err := initCosmosKeyring(
+		opts.cosmosKeyringDir,
+		opts.cosmosKeyringAppName,
+		opts.cosmosKeyringBackend,
+		opts.cosmosKeyFrom,
+		opts.cosmosKeyPassphrase,
+		opts.cosmosPrivKey,
+		opts.cosmosUseLedger,
+	)
+	if err != nil {
+		err = errors.Wrap(err, "failed to init Cosmos keyring")
+		return nil, err
+	}
+
+	clientCtx, err := chainclient.NewClientContext(*opts.cosmosChainID, senderAddress.String(), cosmosKeyring)
+	if err != nil {
+		err = errors.Wrap(err, "failed to initialize cosmos client context")
+		return nil, err
+	}
+
+	tmRPC, err := rpchttp.New(*opts.tendermintRPC, "/websocket")
+	if err != nil {
+		err = errors.Wrap(err, "failed to connect to tendermint RPC")
+		return nil, err
+	}
+
+	clientCtx = clientCtx.
+		WithNodeURI(*opts.tendermintRPC).
+		WithClient(tmRPC)
+
+	cosmosClient, err := chainclient.NewCosmosClient(clientCtx, *opts.cosmosGRPC, chainclient.OptionGasPrices(*opts.cosmosGasPrices))
+	if err != nil {
+		err = errors.Wrapf(err, "failed to connect to daemon at %s, is injectived running?", *opts.cosmosGRPC)
+		return nil, err
+	}
+
+	return cosmosClient, nil
+}
+
+// broadcastMsg signs the msg with the configured key and waits for the Tx result.
+func (opts *cosmosTxCmdOptions) broadcastMsg(buildMsg func(sender sdk.AccAddress) (sdk.Msg, error)) {
+	cosmosClient, err := opts.cosmosClient()
+	orFatal(err)
+	defer cosmosClient.Close()
+
+	msg, err := buildMsg(cosmosClient.FromAddress())
+	orFatal(err)
+
+	err = msg.ValidateBasic()
+	orFatal(err)
+
+	txResp, err := cosmosClient.SyncBroadcastMsg(msg)
+	orFatal(err)
+
+	if txResp.Code != 0 {
+		log.Fatalf("Tx %s failed: code %d (%s): %s", txResp.TxHash, txResp.Code, txResp.Codespace, txResp.RawLog)
+	}
+
+	log.WithFields(log.Fields{
+		"txHash": txResp.TxHash,
+		"height": txResp.Height,
+		"sender": cosmosClient.FromAddress().String(),
+	}).Infoln("Tx successfully included")
+}
+
+func feedsCmd(cmd *cli.Cmd) {
+	cmd.Command("fund-reward-pool", "Transfer funds from the sender to the feed reward pool", feedsFundRewardPool)
+	cmd.Command("withdraw-reward-pool", "Withdraw funds from the feed reward pool to the sender (feed admin only)", feedsWithdrawRewardPool)
+	cmd.Command("owed", "Show the amounts owed to transmitters of the feed", feedsOwed)
+}
+
+func payeeCmd(cmd *cli.Cmd) {
+	cmd.Command("set", "Set payees for transmitters of the feed (feed admin only)", payeeSet)
+	cmd.Command("transfer", "Propose a new payee for the transmitter (current payee only)", payeeTransfer)
+	cmd.Command("accept", "Accept the proposed payeeship for the transmitter (proposed payee only)", payeeAccept)
+}
+
+func feedsFundRewardPool(c *cli.Cmd) {
+	opts := initCosmosTxCmdOptions(c)
+	feedID := c.StringArg("FEED_ID", "", "Specify the feed ID")
+	amount := c.StringArg("AMOUNT", "", "Specify the amount as sdk.Coin, e.g. 1000000000000000000peggy0x514910771AF9Ca656af840dff83E8264EcF986CA")
+
+	c.Action = func() {
+		opts.broadcastMsg(func(sender sdk.AccAddress) (sdk.Msg, error) {
+			coin, err := sdk.ParseCoinNormalized(*amount)
+			if err != nil {
+				err = errors.Wrap(err, "failed to parse amount")
+				return nil, err
+			}
+
+			msg := &chaintypes.MsgFundFeedRewardPool{
+				Sender: sender.String(),
+				FeedId: *feedID,
+				Amount: coin,
+			}
+
+			return msg, nil
+		})
+	}
+}
+
+func feedsWithdrawRewardPool(c *cli.Cmd) {
+	opts := initCosmosTxCmdOptions(c)
+	feedID := c.StringArg("FEED_ID", "", "Specify the feed ID")
+	amount := c.StringArg("AMOUNT", "", "Specify the amount as sdk.Coin")
+
+	c.Action = func() {
+		opts.broadcastMsg(func(sender sdk.AccAddress) (sdk.Msg, error) {
+			coin, err := sdk.ParseCoinNormalized(*amount)
+			if err != nil {
+				err = errors.Wrap(err, "failed to parse amount")
+				return nil, err
+			}
+
+			msg := &chaintypes.MsgWithdrawFeedRewardPool{
+				Sender: sender.String(),
+				FeedId: *feedID,
+				Amount: coin,
+			}
+
+			return msg, nil
+		})
+	}
+}
+
+func feedsOwed(c *cli.Cmd) {
+	var (
+		cosmosChainID   *string
+		cosmosGRPC      *string
+		tendermintRPC   *string
+		cosmosGasPrices *string
+	)
+
+	initCosmosOptions(
+		c,
+		&cosmosChainID,
+		&cosmosGRPC,
+		&tendermintRPC,
+		&cosmosGasPrices,
+	)
+
+	transmitters := c.Strings(cli.StringsOpt{
+		Name:  "transmitters",
+		Desc:  "Specify transmitter addresses, otherwise all transmitters of the feed config are shown.",
+		Value: []string{},
+	})
+
+	feedID := c.StringArg("FEED_ID", "", "Specify the feed ID")
+
+	c.Action = func() {
+		conn, err := grpcDialEndpoint(*cosmosGRPC)
+		orFatal(err)
+		defer conn.Close()
+
+		queryClient := chaintypes.NewQueryClient(conn)
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), cosmosQueryTimeout)
+		defer cancelFn()
+
+		if len(*transmitters) == 0 {
+			resp, err := queryClient.FeedConfig(ctx, &chaintypes.QueryFeedConfigRequest{
+				FeedId: *feedID,
+			})
+			orFatal(errors.Wrap(err, "failed to query feed config"))
+
+			if resp.FeedConfig == nil {
+				log.Fatalln("feed config not found:", *feedID)
+			}
+
+			*transmitters = resp.FeedConfig.Transmitters
+		}
+
+		for _, transmitter := range *transmitters {
+			resp, err := queryClient.OwedAmount(ctx, &chaintypes.QueryOwedAmountRequest{
+				Transmitter: transmitter,
+			})
+			orFatal(errors.Wrapf(err, "failed to query owed amount of %s", transmitter))
+
+			fmt.Printf("%s\t%s\n", transmitter, resp.Amount.String())
+		}
+	}
+}
+
+func payeeSet(c *cli.Cmd) {
+	opts := initCosmosTxCmdOptions(c)
+
+	transmitters := c.Strings(cli.StringsOpt{
+		Name:  "transmitters",
+		Desc:  "Specify transmitter addresses of the feed.",
+		Value: []string{},
+	})
+
+	payees := c.Strings(cli.StringsOpt{
+		Name:  "payees",
+		Desc:  "Specify payee addresses, corresponding to the list of transmitters.",
+		Value: []string{},
+	})
+
+	feedID := c.StringArg("FEED_ID", "", "Specify the feed ID")
+
+	c.Action = func() {
+		opts.broadcastMsg(func(sender sdk.AccAddress) (sdk.Msg, error) {
+			if len(*transmitters) != len(*payees) {
+				err := errors.Errorf("got %d transmitters, but %d payees", len(*transmitters), len(*payees))
+				return nil, err
+			}
+
+			msg := &chaintypes.MsgSetPayees{
+				Sender:       sender.String(),
+				FeedId:       *feedID,
+				Transmitters: *transmitters,
+				Payees:       *payees,
+			}
+
+			return msg, nil
+		})
+	}
+}
+
+func payeeTransfer(c *cli.Cmd) {
+	opts := initCosmosTxCmdOptions(c)
+	feedID := c.StringArg("FEED_ID", "", "Specify the feed ID")
+	transmitter := c.StringArg("TRANSMITTER", "", "Specify the transmitter address")
+	proposed := c.StringArg("PROPOSED", "", "Specify the proposed payee address")
+
+	c.Action = func() {
+		opts.broadcastMsg(func(sender sdk.AccAddress) (sdk.Msg, error) {
+			msg := &chaintypes.MsgTransferPayeeship{
+				Sender:      sender.String(),
+				Transmitter: *transmitter,
+				FeedId:      *feedID,
+				Proposed:    *proposed,
+			}
+
+			return msg, nil
+		})
+	}
+}
+
+func payeeAccept(c *cli.Cmd) {
+	opts := initCosmosTxCmdOptions(c)
+	feedID := c.StringArg("FEED_ID", "", "Specify the feed ID")
+	transmitter := c.StringArg("TRANSMITTER", "", "Specify the transmitter address")
+
+	c.Action = func() {
+		opts.broadcastMsg(func(sender sdk.AccAddress) (sdk.Msg, error) {
+			msg := &chaintypes.MsgAcceptPayeeship{
+				Payee:       sender.String(),
+				Transmitter: *transmitter,
+				FeedId:      *feedID,
+			}
+
+			return msg, nil
+		})
+	}
+}
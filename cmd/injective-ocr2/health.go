@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/InjectiveLabs/chainlink-injective/api"
+	"github.com/InjectiveLabs/chainlink-injective/db"
+	"github.com/InjectiveLabs/chainlink-injective/injective/tmclient"
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+const grpcHealthCheckTimeout = 3 * time.Second
+
+// grpcHealthCheck queries OCR module params, since the connection state alone
+// doesn't tell if the endpoint is alive: an idle connection is not probed at all.
+func grpcHealthCheck(conn *grpc.ClientConn) api.HealthCheck {
+	queryClient := chaintypes.NewQueryClient(conn)
+
+	return func(ctx context.Context) error {
+		ctx, cancelFn := context.WithTimeout(ctx, grpcHealthCheckTimeout)
+		defer cancelFn()
+
+		if _, err := queryClient.Params(ctx, &chaintypes.QueryParamsRequest{}); err != nil {
+			err = errors.Wrapf(err, "gRPC query failed, connection state is %s", conn.GetState().String())
+			return err
+		}
+
+		return nil
+	}
+}
+
+func tendermintHealthCheck(tmClient tmclient.TendermintClient) api.HealthCheck {
+	return func(ctx context.Context) error {
+		if _, err := tmClient.GetLatestBlockHeight(ctx); err != nil {
+			err = errors.Wrap(err, "failed to get latest block height")
+			return err
+		}
+
+		return nil
+	}
+}
+
+func sqlHealthCheck(dbGorm db.ExternalGorm) api.HealthCheck {
+	return func(ctx context.Context) error {
+		sqlConn, err := dbGorm.Connection()
+		if err != nil {
+			err = errors.Wrap(err, "failed to get SQL connection")
+			return err
+		}
+
+		if err := sqlConn.PingContext(ctx); err != nil {
+			err = errors.Wrap(err, "SQL connection test failed")
+			return err
+		}
+
+		return nil
+	}
+}
@@ -32,6 +32,10 @@ func main() {
 
 	app.Command("start", "Starts the OCR2 service.", startCmd)
 	app.Command("keys", "Keys management.", keysCmd)
+	app.Command("feeds", "Feed reward pool management and owed amounts.", feedsCmd)
+	app.Command("payee", "Payees management of feed transmitters.", payeeCmd)
+	app.Command("config", "Feed config authoring and SetConfig proposals.", configCmd)
+	app.Command("db", "DB schema migrations, backup and restore.", dbCmd)
 	app.Command("version", "Print the version information and exit.", versionCmd)
 
 	_ = app.Run(os.Args)
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"net/http"
 	"os"
 	"time"
 
@@ -16,12 +18,28 @@ import (
 // startMetricsGathering initializes metric reporting client,
 // if not globally disabled by the config.
 func startMetricsGathering(
+	metricsBackend *string,
 	statsdPrefix *string,
 	statsdAddr *string,
 	statsdStuckDur *string,
 	statsdMocking *string,
 	statsdDisabled *string,
+	prometheusListenAddr *string,
 ) {
+	switch *metricsBackend {
+	case "prometheus":
+		startPrometheusExporter(
+			statsdPrefix,
+			statsdStuckDur,
+			prometheusListenAddr,
+		)
+
+		return
+	case "statsd":
+	default:
+		log.Fatalln("Unsupported metrics backend:", *metricsBackend)
+	}
+
 	if toBool(*statsdDisabled) {
 		// initializes statsd client with a mock one with no-op enabled
 		metrics.Disable()
@@ -51,3 +69,45 @@ func startMetricsGathering(
 	}()
 
 }
+
+// startPrometheusExporter initializes Prometheus metrics backend
+// and serves the /metrics endpoint.
+func startPrometheusExporter(
+	metricsPrefix *string,
+	metricsStuckDur *string,
+	prometheusListenAddr *string,
+) {
+	hostname, _ := os.Hostname()
+	handler, err := metrics.InitPrometheus(*metricsPrefix, &metrics.StatterConfig{
+		EnvName:              *envName,
+		HostName:             hostname,
+		StuckFunctionTimeout: duration(*metricsStuckDur, 30*time.Minute),
+	})
+	if err != nil {
+		log.WithError(err).Fatalln("failed to init Prometheus metrics")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+
+	srv := &http.Server{
+		Addr:    *prometheusListenAddr,
+		Handler: mux,
+	}
+
+	go func() {
+		log.Infoln("Serving Prometheus metrics on", *prometheusListenAddr)
+
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Errorln("Prometheus metrics endpoint failed")
+		}
+	}()
+
+	closer.Bind(func() {
+		ctx, cancelFn := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelFn()
+
+		srv.Shutdown(ctx)
+		metrics.Close()
+	})
+}
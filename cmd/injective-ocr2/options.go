@@ -181,6 +181,7 @@ func initCosmosOptions(
 	cosmosGRPC **string,
 	tendermintRPC **string,
 	cosmosGasPrices **string,
+	cosmosJuelsMinVersion **string,
 ) {
 	*cosmosChainID = cmd.String(cli.StringOpt{
 		Name:   "cosmos-chain-id",
@@ -205,9 +206,92 @@ func initCosmosOptions(
 
 	*cosmosGasPrices = cmd.String(cli.StringOpt{
 		Name:   "cosmos-gas-prices",
-		Desc:   "Specify Cosmos chain transaction fees as sdk.Coins gas prices",
+		Desc:   "Specify Cosmos chain transaction fees as sdk.Coins gas prices, empty sends transmissions once without fee bumps",
 		EnvVar: "ORACLE_COSMOS_GAS_PRICES",
-		Value:  "", // example: 500000000inj
+		Value:  "500000000inj",
+	})
+
+	*cosmosJuelsMinVersion = cmd.String(cli.StringOpt{
+		Name:   "cosmos-juels-per-fee-coin-min-version",
+		Desc:   "Specify the chain version, whose OCR module reports carry JuelsPerFeeCoin. Empty keeps JuelsPerFeeCoin out of reports.",
+		EnvVar: "ORACLE_COSMOS_JUELS_PER_FEE_COIN_MIN_VERSION",
+		Value:  "", // example: v1.5.0
+	})
+}
+
+func initCosmosTxOptions(
+	cmd *cli.Cmd,
+	cosmosMaxGasPrices **string,
+	cosmosGasAdjustment **float64,
+	cosmosFeeBumpFactor **float64,
+	cosmosTxBatchSize **int,
+	cosmosTxBatchWindow **string,
+) {
+	*cosmosMaxGasPrices = cmd.String(cli.StringOpt{
+		Name:   "cosmos-max-gas-prices",
+		Desc:   "Specify the ceiling of gas prices for fee bumps, defaults to cosmos-gas-prices (no bumps)",
+		EnvVar: "ORACLE_COSMOS_MAX_GAS_PRICES",
+		Value:  "", // example: 2500000000inj
+	})
+
+	*cosmosGasAdjustment = cmd.Float64(cli.Float64Opt{
+		Name:   "cosmos-gas-adjustment",
+		Desc:   "Specify the multiplier for simulated gas of transmission Txs",
+		EnvVar: "ORACLE_COSMOS_GAS_ADJUSTMENT",
+		Value:  1.5,
+	})
+
+	*cosmosFeeBumpFactor = cmd.Float64(cli.Float64Opt{
+		Name:   "cosmos-fee-bump-factor",
+		Desc:   "Specify the gas prices multiplier applied when a Tx is rejected for low fee or full mempool",
+		EnvVar: "ORACLE_COSMOS_FEE_BUMP_FACTOR",
+		Value:  1.25,
+	})
+
+	*cosmosTxBatchSize = cmd.Int(cli.IntOpt{
+		Name:   "cosmos-tx-batch-size",
+		Desc:   "Specify max number of MsgTransmit batched into a single Tx across all jobs",
+		EnvVar: "ORACLE_COSMOS_TX_BATCH_SIZE",
+		Value:  10,
+	})
+
+	*cosmosTxBatchWindow = cmd.String(cli.StringOpt{
+		Name:   "cosmos-tx-batch-window",
+		Desc:   "Specify how long to wait for more transmissions to batch, when several are pending",
+		EnvVar: "ORACLE_COSMOS_TX_BATCH_WINDOW",
+		Value:  "200ms",
+	})
+}
+
+func initCosmosTransmitterOptions(
+	cmd *cli.Cmd,
+	cosmosTransmitterKeys **[]string,
+) {
+	*cosmosTransmitterKeys = cmd.Strings(cli.StringsOpt{
+		Name:   "cosmos-transmitter-keys",
+		Desc:   "Specify additional transmitter key names or addresses from the keyring, assigned to feeds along with the sender key.",
+		EnvVar: "ORACLE_COSMOS_TRANSMITTER_KEYS",
+		Value:  []string{},
+	})
+}
+
+func initBalanceMonitorOptions(
+	cmd *cli.Cmd,
+	transmitterMinBalance **string,
+	transmitterBalanceCheckInterval **string,
+) {
+	*transmitterMinBalance = cmd.String(cli.StringOpt{
+		Name:   "transmitter-min-balance",
+		Desc:   "Specify the fee balance threshold of transmitter accounts, below which warnings are logged and readiness fails. Zero amount disables the check, balances are still reported.",
+		EnvVar: "ORACLE_TRANSMITTER_MIN_BALANCE",
+		Value:  "0inj", // example: 100000000000000000inj
+	})
+
+	*transmitterBalanceCheckInterval = cmd.String(cli.StringOpt{
+		Name:   "transmitter-balance-check-interval",
+		Desc:   "Specify how often the transmitter balances are checked.",
+		EnvVar: "ORACLE_TRANSMITTER_BALANCE_CHECK_INTERVAL",
+		Value:  "1m",
 	})
 }
 
@@ -340,10 +424,11 @@ func initDBOptions(
 	dbMongoConnection **string,
 	dbMongoDBName **string,
 	dbPostgresURL **string,
+	dbBoltPath **string,
 ) {
 	*dbEngine = c.String(cli.StringOpt{
 		Name:   "D db-engine",
-		Desc:   "Specify DB engine to use: mongo, postgres (default).",
+		Desc:   "Specify DB engine to use: mongo, postgres (default), bolt, memory.",
 		EnvVar: "ORACLE_DB_ENGINE",
 		Value:  "postgres",
 	})
@@ -369,6 +454,12 @@ func initDBOptions(
 		Value:  "postgresql://localhost:5432/chainlink_test",
 	})
 
+	*dbBoltPath = c.String(cli.StringOpt{
+		Name:   "bolt-path",
+		Desc:   "Specify path to the embedded DB file, used with bolt engine.",
+		EnvVar: "ORACLE_DB_BOLT_PATH",
+		Value:  "data/ocr2.db",
+	})
 }
 
 // initStatsdOptions sets options for StatsD metrics.
@@ -415,3 +506,23 @@ func initStatsdOptions(
 		Value:  "true",
 	})
 }
+
+func initPrometheusOptions(
+	cmd *cli.Cmd,
+	metricsBackend **string,
+	prometheusListenAddr **string,
+) {
+	*metricsBackend = cmd.String(cli.StringOpt{
+		Name:   "metrics-backend",
+		Desc:   "Metrics backend to use: statsd or prometheus. Prometheus metric names are prefixed with statsd-prefix.",
+		EnvVar: "ORACLE_METRICS_BACKEND",
+		Value:  "statsd",
+	})
+
+	*prometheusListenAddr = cmd.String(cli.StringOpt{
+		Name:   "prometheus-listen-addr",
+		Desc:   "Listen address for Prometheus /metrics endpoint, if Prometheus backend is used.",
+		EnvVar: "ORACLE_PROMETHEUS_LISTEN_ADDR",
+		Value:  "localhost:9107",
+	})
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"net/url"
 	"os"
 	"time"
 
@@ -15,12 +14,15 @@ import (
 	log "github.com/xlab/suplog"
 
 	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
 
 	"github.com/InjectiveLabs/chainlink-injective/api"
 	"github.com/InjectiveLabs/chainlink-injective/chainlink"
-	"github.com/InjectiveLabs/chainlink-injective/db"
-	"github.com/InjectiveLabs/chainlink-injective/db/dbconn"
+	"github.com/InjectiveLabs/chainlink-injective/injective"
 	"github.com/InjectiveLabs/chainlink-injective/injective/tmclient"
+	"github.com/InjectiveLabs/chainlink-injective/injective/txbroadcaster"
 	ocrtypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
 	"github.com/InjectiveLabs/chainlink-injective/ocr2"
 	"github.com/InjectiveLabs/chainlink-injective/p2p"
@@ -37,6 +39,19 @@ func startCmd(cmd *cli.Cmd) {
 		tendermintRPC   *string
 		cosmosGasPrices *string
 
+		cosmosJuelsMinVersion *string
+
+		cosmosMaxGasPrices  *string
+		cosmosGasAdjustment *float64
+		cosmosFeeBumpFactor *float64
+		cosmosTxBatchSize   *int
+		cosmosTxBatchWindow *string
+
+		cosmosTransmitterKeys *[]string
+
+		transmitterMinBalance           *string
+		transmitterBalanceCheckInterval *string
+
 		// Cosmos Key Management
 		cosmosKeyringDir     *string
 		cosmosKeyringAppName *string
@@ -72,6 +87,7 @@ func startCmd(cmd *cli.Cmd) {
 		dbMongoConnection *string
 		dbMongoDBName     *string
 		dbPostgresURL     *string
+		dbBoltPath        *string
 
 		eiChainlinkURL *string
 		eiAccessKeyIC  *string
@@ -86,6 +102,9 @@ func startCmd(cmd *cli.Cmd) {
 		statsdStuckDur *string
 		statsdMocking  *string
 		statsdDisabled *string
+
+		metricsBackend       *string
+		prometheusListenAddr *string
 	)
 
 	initCosmosOptions(
@@ -94,6 +113,27 @@ func startCmd(cmd *cli.Cmd) {
 		&cosmosGRPC,
 		&tendermintRPC,
 		&cosmosGasPrices,
+		&cosmosJuelsMinVersion,
+	)
+
+	initCosmosTxOptions(
+		cmd,
+		&cosmosMaxGasPrices,
+		&cosmosGasAdjustment,
+		&cosmosFeeBumpFactor,
+		&cosmosTxBatchSize,
+		&cosmosTxBatchWindow,
+	)
+
+	initCosmosTransmitterOptions(
+		cmd,
+		&cosmosTransmitterKeys,
+	)
+
+	initBalanceMonitorOptions(
+		cmd,
+		&transmitterMinBalance,
+		&transmitterBalanceCheckInterval,
 	)
 
 	initCosmosKeyOptions(
@@ -144,6 +184,7 @@ func startCmd(cmd *cli.Cmd) {
 		&dbMongoConnection,
 		&dbMongoDBName,
 		&dbPostgresURL,
+		&dbBoltPath,
 	)
 
 	initChainlinkOptions(
@@ -165,6 +206,12 @@ func startCmd(cmd *cli.Cmd) {
 		&statsdDisabled,
 	)
 
+	initPrometheusOptions(
+		cmd,
+		&metricsBackend,
+		&prometheusListenAddr,
+	)
+
 	cmd.Action = func() {
 		// ensure a clean exit
 		defer closer.Close()
@@ -174,11 +221,13 @@ func startCmd(cmd *cli.Cmd) {
 		})
 
 		startMetricsGathering(
+			metricsBackend,
 			statsdPrefix,
 			statsdAddr,
 			statsdStuckDur,
 			statsdMocking,
 			statsdDisabled,
+			prometheusListenAddr,
 		)
 
 		if *cosmosUseLedger {
@@ -229,65 +278,82 @@ func startCmd(cmd *cli.Cmd) {
 		waitForService(daemonWaitCtx, daemonConn)
 		cancelWait()
 
-		var dbDriver ocr2.DBDriver
-
-		switch *dbEngine {
-		case "postgres":
-			// Initialize PostgreSQL connection
-			//
-
-			log.Infoln("Connecting to DB using Gorm (PostgreSQL)")
-
-			pgURL, err := url.ParseRequestURI(*dbPostgresURL)
-			if err != nil {
-				log.WithError(err).Fatalln("failed to parse PostgreSQL URL: %s", *dbPostgresURL)
-			}
+		txBroadcaster, err := initTxBroadcaster(
+			clientCtx,
+			daemonConn,
+			cosmosClient,
+			cosmosGasPrices,
+			cosmosMaxGasPrices,
+			cosmosGasAdjustment,
+			cosmosFeeBumpFactor,
+			cosmosTxBatchSize,
+			cosmosTxBatchWindow,
+		)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to init Cosmos Tx broadcaster")
+		}
+		closer.Bind(func() {
+			txBroadcaster.Close()
+		})
 
-			dbGorm, err := db.NewExternalPostgres(pgURL)
-			if err != nil {
-				log.WithError(err).Fatalln("failed to connect to PostgreSQL instance")
-			}
+		transmitterPool, err := initTransmitterPool(
+			&ocr2.TransmitterAccount{
+				Address:       senderAddress,
+				CosmosClient:  cosmosClient,
+				TxBroadcaster: txBroadcaster,
+			},
+			clientCtx,
+			cosmosKeyring,
+			cosmosChainID,
+			cosmosGRPC,
+			cosmosGasPrices,
+			cosmosMaxGasPrices,
+			cosmosGasAdjustment,
+			cosmosFeeBumpFactor,
+			cosmosTxBatchSize,
+			cosmosTxBatchWindow,
+			cosmosTransmitterKeys,
+		)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to init Cosmos transmitter accounts")
+		}
 
-			dbDriver = dbGorm
+		tmClient := tmclient.NewRPCClient(*tendermintRPC)
 
-		case "mongo":
-			// Setup MongoDB database connection
-			//
+		balanceMonitor, err := initBalanceMonitor(
+			transmitterPool,
+			daemonConn,
+			transmitterMinBalance,
+			transmitterBalanceCheckInterval,
+		)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to init transmitter balance monitor")
+		}
 
-			log.Infoln("Connecting to MongoDB")
+		if err := balanceMonitor.Start(); err != nil {
+			log.WithError(err).Fatalln("failed to start transmitter balance monitor")
+		}
+		closer.Bind(func() {
+			balanceMonitor.Close()
+		})
 
-			dbConnContext, cancelFn := context.WithTimeout(context.Background(), 20*time.Second)
-			dbConn, err := dbconn.NewMongoConn(dbConnContext, &dbconn.MongoConfig{
-				Connection: *dbMongoConnection,
-				Database:   *dbMongoDBName,
-			})
-			if err != nil {
-				log.WithError(err).Fatalln("failed to init MongoDB client")
-			} else if err := dbConn.TestConn(dbConnContext); err != nil {
-				log.Fatalln(err)
-			}
-			cancelFn()
-
-			closer.Bind(func() {
-				if err := dbConn.Close(); err != nil {
-					log.WithError(err).Warningln("failed to close MongoDB connection")
-				}
-			})
-
-			dbSvc, err := db.NewDBService(dbConn)
-			if err != nil {
-				err = errors.Wrap(err, "failed to init DB service")
-				log.Fatalln(err)
-			}
-			closer.Bind(func() {
-				dbSvc.Close()
-			})
+		healthChecks := api.HealthChecks{
+			"injective_grpc":      grpcHealthCheck(daemonConn),
+			"tendermint_rpc":      tendermintHealthCheck(tmClient),
+			"transmitter_balance": balanceMonitor.CheckHealth,
+		}
 
-			log.Infoln("Successfully connected to MongoDB")
+		dbStorage, dbHealthCheck, dbCloseFn := openStorage(
+			*dbEngine,
+			*dbMongoConnection,
+			*dbMongoDBName,
+			*dbPostgresURL,
+			*dbBoltPath,
+		)
+		closer.Bind(dbCloseFn)
 
-			dbDriver = dbSvc
-		default:
-			log.Fatalln("Unsupported DB engine:", *dbEngine)
+		if dbHealthCheck != nil {
+			healthChecks["db"] = dbHealthCheck
 		}
 
 		// Init Chainlink Node Webhook client
@@ -299,6 +365,10 @@ func startCmd(cmd *cli.Cmd) {
 			*eiSecretIC,
 		)
 
+		if len(*eiChainlinkURL) > 0 {
+			healthChecks["chainlink_node"] = webhookClient.CheckHealth
+		}
+
 		// Parse P2P Network options and identity
 		//
 
@@ -353,17 +423,19 @@ func startCmd(cmd *cli.Cmd) {
 		//
 
 		jobSvc, err := ocr2.NewJobService(
-			dbDriver,
+			dbStorage,
 			webhookClient,
 			peerKey,
 			p2pNetworkConfig,
 			ocrKey,
 			*cosmosChainID,
 			ocrtypes.NewQueryClient(daemonConn),
-			cosmosClient,
-			tmclient.NewRPCClient(*tendermintRPC),
+			transmitterPool,
+			clientCtx.TxConfig.TxDecoder(),
+			tmClient,
 			senderAddress,
 			cosmosKeyring,
+			reportJuelsPerFeeCoin(daemonConn, *cosmosJuelsMinVersion),
 		)
 		if err != nil {
 			err = errors.Wrap(err, "failed to init OCR2 JobService")
@@ -381,6 +453,7 @@ func startCmd(cmd *cli.Cmd) {
 		apiSrv, err := api.NewServer(
 			apiCredentials,
 			jobSvc,
+			healthChecks,
 		)
 
 		go func() {
@@ -400,6 +473,95 @@ func startCmd(cmd *cli.Cmd) {
 	}
 }
 
+// initTxBroadcaster inits the node-wide Tx queue for transmissions. If no gas prices are set,
+// Txs are sent once using CosmosClient, without simulation and fee bumps.
+func initTxBroadcaster(
+	clientCtx client.Context,
+	daemonConn *grpc.ClientConn,
+	cosmosClient chainclient.CosmosClient,
+	cosmosGasPrices *string,
+	cosmosMaxGasPrices *string,
+	cosmosGasAdjustment *float64,
+	cosmosFeeBumpFactor *float64,
+	cosmosTxBatchSize *int,
+	cosmosTxBatchWindow *string,
+) (txbroadcaster.TxQueue, error) {
+	batchWindow, err := time.ParseDuration(*cosmosTxBatchWindow)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse duration cosmosTxBatchWindow")
+		return nil, err
+	}
+
+	queueConfig := txbroadcaster.QueueConfig{
+		MaxBatchSize: *cosmosTxBatchSize,
+		BatchWindow:  batchWindow,
+	}
+
+	if len(*cosmosGasPrices) == 0 {
+		log.Warningln("no Cosmos gas prices set, transmissions will be sent without retries and fee bumps")
+
+		broadcaster := txbroadcaster.NewCosmosClientBroadcaster(cosmosClient)
+		return txbroadcaster.NewTxQueue(broadcaster, queueConfig), nil
+	}
+
+	gasPrices, err := sdk.ParseDecCoins(*cosmosGasPrices)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse cosmos-gas-prices")
+		return nil, err
+	}
+
+	maxGasPrices, err := sdk.ParseDecCoins(*cosmosMaxGasPrices)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse cosmos-max-gas-prices")
+		return nil, err
+	}
+
+	broadcaster, err := txbroadcaster.NewTxBroadcaster(clientCtx, daemonConn, txbroadcaster.Config{
+		GasPrices:     gasPrices,
+		MaxGasPrices:  maxGasPrices,
+		GasAdjustment: *cosmosGasAdjustment,
+		FeeBumpFactor: *cosmosFeeBumpFactor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txbroadcaster.NewTxQueue(broadcaster, queueConfig), nil
+}
+
+// reportJuelsPerFeeCoin checks if the chain version supports JuelsPerFeeCoin in reports.
+// Reports carrying the field would fail signature checks on older chains, so it's
+// kept out of reports if the version is unknown. The check runs once upon start,
+// restart the oracle after a chain upgrade.
+func reportJuelsPerFeeCoin(daemonConn *grpc.ClientConn, minChainVersion string) bool {
+	if len(minChainVersion) == 0 {
+		return false
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+
+	chainVersion, err := injective.ChainAppVersion(ctx, daemonConn)
+	if err != nil {
+		log.WithError(err).Warningln("failed to get chain version, JuelsPerFeeCoin is not reported")
+		return false
+	}
+
+	supported, err := injective.ChainVersionAtLeast(chainVersion, minChainVersion)
+	if err != nil {
+		log.WithError(err).Warningln("failed to check chain version, JuelsPerFeeCoin is not reported")
+		return false
+	}
+
+	log.WithFields(log.Fields{
+		"chainVersion":    chainVersion,
+		"minChainVersion": minChainVersion,
+		"reported":        supported,
+	}).Infoln("checked chain support of JuelsPerFeeCoin in reports")
+
+	return supported
+}
+
 func parseP2PNetworkOptions(
 	p2pDHTLookupInterval *string,
 	p2pIncomingMessageBufferSize *int,
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/xlab/closer"
+	log "github.com/xlab/suplog"
+	"google.golang.org/grpc"
+
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+
+	"github.com/InjectiveLabs/chainlink-injective/injective"
+	ocrtypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+	"github.com/InjectiveLabs/chainlink-injective/ocr2"
+)
+
+// initTransmitterPool inits a client and Tx broadcaster for each of additional transmitter keys,
+// the keys are specified by name or address and must exist in the keyring.
+// The pool always starts with the primary sender account.
+func initTransmitterPool(
+	primary *ocr2.TransmitterAccount,
+	clientCtx client.Context,
+	cosmosKeyring keyring.Keyring,
+	cosmosChainID *string,
+	cosmosGRPC *string,
+	cosmosGasPrices *string,
+	cosmosMaxGasPrices *string,
+	cosmosGasAdjustment *float64,
+	cosmosFeeBumpFactor *float64,
+	cosmosTxBatchSize *int,
+	cosmosTxBatchWindow *string,
+	cosmosTransmitterKeys *[]string,
+) (*ocr2.TransmitterPool, error) {
+	accounts := []*ocr2.TransmitterAccount{primary}
+
+	for _, keyFrom := range *cosmosTransmitterKeys {
+		keyClientCtx, err := chainclient.NewClientContext(*cosmosChainID, keyFrom, cosmosKeyring)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to init client context for transmitter key %s", keyFrom)
+			return nil, err
+		}
+
+		keyClientCtx = keyClientCtx.
+			WithNodeURI(clientCtx.NodeURI).
+			WithClient(clientCtx.Client)
+
+		keyCosmosClient, err := chainclient.NewCosmosClient(keyClientCtx, *cosmosGRPC, chainclient.OptionGasPrices(*cosmosGasPrices))
+		if err != nil {
+			err = errors.Wrapf(err, "failed to init Cosmos client for transmitter key %s", keyFrom)
+			return nil, err
+		}
+		closer.Bind(func() {
+			keyCosmosClient.Close()
+		})
+
+		keyTxBroadcaster, err := initTxBroadcaster(
+			keyClientCtx,
+			keyCosmosClient.QueryClient(),
+			keyCosmosClient,
+			cosmosGasPrices,
+			cosmosMaxGasPrices,
+			cosmosGasAdjustment,
+			cosmosFeeBumpFactor,
+			cosmosTxBatchSize,
+			cosmosTxBatchWindow,
+		)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to init Tx broadcaster for transmitter key %s", keyFrom)
+			return nil, err
+		}
+		closer.Bind(func() {
+			keyTxBroadcaster.Close()
+		})
+
+		log.Infoln("Using Cosmos Transmitter", keyClientCtx.GetFromAddress().String())
+
+		accounts = append(accounts, &ocr2.TransmitterAccount{
+			Address:       keyClientCtx.GetFromAddress(),
+			CosmosClient:  keyCosmosClient,
+			TxBroadcaster: keyTxBroadcaster,
+		})
+	}
+
+	return ocr2.NewTransmitterPool(accounts...)
+}
+
+func initBalanceMonitor(
+	transmitterPool *ocr2.TransmitterPool,
+	daemonConn *grpc.ClientConn,
+	transmitterMinBalance *string,
+	transmitterBalanceCheckInterval *string,
+) (*injective.BalanceMonitor, error) {
+	minBalance, err := sdk.ParseCoinNormalized(*transmitterMinBalance)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse transmitter-min-balance")
+		return nil, err
+	}
+
+	interval, err := time.ParseDuration(*transmitterBalanceCheckInterval)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse duration transmitterBalanceCheckInterval")
+		return nil, err
+	}
+
+	addresses := make([]sdk.AccAddress, 0, len(transmitterPool.Accounts()))
+	for _, acc := range transmitterPool.Accounts() {
+		addresses = append(addresses, acc.Address)
+	}
+
+	monitor := &injective.BalanceMonitor{
+		Addresses:       addresses,
+		BankQueryClient: banktypes.NewQueryClient(daemonConn),
+		QueryClient:     ocrtypes.NewQueryClient(daemonConn),
+		MinBalance:      minBalance,
+		Interval:        interval,
+	}
+
+	return monitor, nil
+}
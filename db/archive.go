@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+// ArchiveVersion is the format version of archives written by ExportArchive.
+const ArchiveVersion = 1
+
+// ExportArchive dumps active and inactive jobs with their OCR state and peer announcements.
+func ExportArchive(ctx context.Context, storage Storage) (*model.Archive, error) {
+	jobs, err := storage.ListAllJobs(ctx)
+	if err != nil {
+		err = errors.Wrap(err, "failed to list jobs")
+		return nil, err
+	}
+
+	archive := &model.Archive{
+		Version:   ArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+		Jobs:      make([]*model.ArchiveJob, 0, len(jobs)),
+	}
+
+	for _, job := range jobs {
+		archiveJob, err := exportArchiveJob(ctx, storage, job)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to export job %s", job.JobID)
+			return nil, err
+		}
+
+		archive.Jobs = append(archive.Jobs, archiveJob)
+	}
+
+	return archive, nil
+}
+
+func exportArchiveJob(ctx context.Context, storage Storage, job *model.Job) (*model.ArchiveJob, error) {
+	jobDB, err := storage.JobDBService(string(job.JobID))
+	if err != nil {
+		return nil, err
+	}
+	defer jobDB.Close()
+
+	archiveJob := &model.ArchiveJob{
+		Job: job,
+	}
+
+	if archiveJob.PersistentStates, err = jobDB.ListPersistentStates(ctx); err != nil {
+		return nil, err
+	}
+
+	archiveJob.ContractConfig, err = jobDB.GetContractConfig(ctx)
+	if err == ErrNotFound {
+		archiveJob.ContractConfig = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if archiveJob.PendingTransmissions, err = jobDB.ListAllPendingTransmissions(ctx); err != nil {
+		return nil, err
+	}
+
+	if archiveJob.PeerAnnouncements, err = jobDB.ListAllAnnouncements(ctx); err != nil {
+		return nil, err
+	}
+
+	return archiveJob, nil
+}
+
+// ImportArchive restores an archive into the storage. Records already in the storage
+// are overwritten by ones from the archive, other records are kept.
+func ImportArchive(ctx context.Context, storage Storage, archive *model.Archive) error {
+	if archive.Version != ArchiveVersion {
+		return errors.Errorf("unsupported archive version %d, expected %d", archive.Version, ArchiveVersion)
+	}
+
+	for _, archiveJob := range archive.Jobs {
+		if archiveJob.Job == nil {
+			return errors.New("archive contains a job entry without a job")
+		}
+
+		if err := importArchiveJob(ctx, storage, archiveJob); err != nil {
+			err = errors.Wrapf(err, "failed to import job %s", archiveJob.Job.JobID)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func importArchiveJob(ctx context.Context, storage Storage, archiveJob *model.ArchiveJob) error {
+	if err := storage.UpsertJob(ctx, archiveJob.Job); err != nil {
+		return err
+	}
+
+	jobDB, err := storage.JobDBService(string(archiveJob.Job.JobID))
+	if err != nil {
+		return err
+	}
+	defer jobDB.Close()
+
+	for _, state := range archiveJob.PersistentStates {
+		if err := jobDB.SetPersistentState(ctx, state); err != nil {
+			return err
+		}
+	}
+
+	if archiveJob.ContractConfig != nil {
+		if err := jobDB.SetContractConfig(ctx, archiveJob.ContractConfig); err != nil {
+			return err
+		}
+	}
+
+	for _, pendingTx := range archiveJob.PendingTransmissions {
+		if err := jobDB.InsertPendingTranmission(ctx, pendingTx); err != nil {
+			return err
+		}
+	}
+
+	for _, ann := range archiveJob.PeerAnnouncements {
+		if err := jobDB.UpsertAnnouncement(ctx, ann); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+// BoltDBService is an embedded on-disk DB engine backed by bbolt, so a single
+// binary plus a data directory is a complete oracle. Records are stored as JSON,
+// cursors only support Limit.
+type BoltDBService interface {
+	Storage
+
+	Path() string
+}
+
+var _ BoltDBService = &boltDBService{}
+
+var (
+	boltJobsBucket      = []byte("jobs")
+	boltJobStatesBucket = []byte("job_states")
+
+	boltPersistentStatesBucket     = []byte("persistent_states")
+	boltContractConfigBucket       = []byte("contract_config")
+	boltPendingTransmissionsBucket = []byte("pending_transmissions")
+	boltPeerAnnouncementsBucket    = []byte("peer_announcements")
+
+	boltContractConfigKey = []byte("config")
+)
+
+const (
+	boltFileMode    = os.FileMode(0600)
+	boltDataDirMode = os.FileMode(0700)
+	boltOpenTimeout = 5 * time.Second
+)
+
+type boltDBService struct {
+	db   *bolt.DB
+	path string
+
+	svcTags metrics.Tags
+}
+
+// NewBoltDBService opens or creates the DB file at path. The file is locked,
+// so only one process can use it at a time.
+func NewBoltDBService(path string) (BoltDBService, error) {
+	if err := os.MkdirAll(filepath.Dir(path), boltDataDirMode); err != nil {
+		err = errors.Wrap(err, "failed to create data dir")
+		return nil, err
+	}
+
+	db, err := bolt.Open(path, boltFileMode, &bolt.Options{
+		Timeout: boltOpenTimeout,
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "failed to open bolt DB at %s", path)
+		return nil, err
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{boltJobsBucket, boltJobStatesBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		_ = db.Close()
+
+		err = errors.Wrap(err, "failed to init bolt DB buckets")
+		return nil, err
+	}
+
+	d := &boltDBService{
+		db:   db,
+		path: path,
+
+		svcTags: metrics.Tags{
+			"svc": "db",
+		},
+	}
+
+	return d, nil
+}
+
+func (d *boltDBService) Path() string {
+	return d.path
+}
+
+func (d *boltDBService) String() string {
+	return "DB Driver: bolt embedded"
+}
+
+func (d *boltDBService) Close() {
+	_ = d.db.Close()
+}
+
+func (d *boltDBService) UpsertJob(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	if len(job.JobID) == 0 {
+		return errors.New("JobID cannot be empty")
+	}
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx.Bucket(boltJobsBucket), []byte(job.JobID), job)
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltDBService) DeleteJob(
+	ctx context.Context,
+	jobID model.ID,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltJobsBucket).Delete([]byte(jobID))
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to delete documents")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltDBService) ListJobs(
+	ctx context.Context,
+	cursor *model.Cursor,
+) ([]*model.Job, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	return d.listJobs(cursor, true)
+}
+
+func (d *boltDBService) ListAllJobs(
+	ctx context.Context,
+) ([]*model.Job, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	return d.listJobs(nil, false)
+}
+
+func (d *boltDBService) listJobs(cursor *model.Cursor, activeOnly bool) ([]*model.Job, error) {
+	jobs := []*model.Job{}
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltJobsBucket).ForEach(func(_, v []byte) error {
+			var job model.Job
+			if err := json.Unmarshal(v, &job); err != nil {
+				return err
+			}
+
+			if job.IsActive || !activeOnly {
+				jobs = append(jobs, &job)
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt)
+	})
+
+	return jobs[:cursorLen(cursor, len(jobs))], nil
+}
+
+func (d *boltDBService) JobDBService(jobID string) (JobDBService, error) {
+	if len(jobID) == 0 {
+		return nil, errors.New("JobID cannot be empty")
+	}
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		jobBucket, err := tx.Bucket(boltJobStatesBucket).CreateBucketIfNotExists([]byte(jobID))
+		if err != nil {
+			return err
+		}
+
+		for _, bucket := range [][]byte{
+			boltPersistentStatesBucket,
+			boltContractConfigBucket,
+			boltPendingTransmissionsBucket,
+			boltPeerAnnouncementsBucket,
+		} {
+			if _, err := jobBucket.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "failed to init job state buckets for %s", jobID)
+		return nil, err
+	}
+
+	j := &boltJobDBService{
+		db:    d.db,
+		jobID: jobID,
+
+		svcTags: metrics.Tags{
+			"svc": "job_db",
+			"job": jobID,
+		},
+	}
+
+	return j, nil
+}
+
+func boltPut(bucket *bolt.Bucket, key []byte, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put(key, data)
+}
@@ -0,0 +1,437 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+var _ JobDBService = &boltJobDBService{}
+
+type boltJobDBService struct {
+	db    *bolt.DB
+	jobID string
+
+	svcTags metrics.Tags
+}
+
+func (d *boltJobDBService) JobID() model.ID {
+	return model.ID(d.jobID)
+}
+
+func (d *boltJobDBService) Close() {
+	return
+}
+
+// bucket returns a sub-bucket of the job states, created by BoltDBService.JobDBService.
+func (d *boltJobDBService) bucket(tx *bolt.Tx, name []byte) *bolt.Bucket {
+	return tx.Bucket(boltJobStatesBucket).Bucket([]byte(d.jobID)).Bucket(name)
+}
+
+func (d *boltJobDBService) SetPersistentState(
+	ctx context.Context,
+	state *model.JobPersistentState,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	state.JobID = model.ID(d.jobID)
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(d.bucket(tx, boltPersistentStatesBucket), []byte(state.ConfigDigest), state)
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltJobDBService) GetPersistentState(
+	ctx context.Context,
+	configDigest model.ID,
+) (*model.JobPersistentState, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var state *model.JobPersistentState
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		data := d.bucket(tx, boltPersistentStatesBucket).Get([]byte(configDigest))
+		if data == nil {
+			return nil
+		}
+
+		state = new(model.JobPersistentState)
+		return json.Unmarshal(data, state)
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to query document")
+		return nil, err
+	} else if state == nil {
+		metrics.ReportFuncError(d.svcTags)
+		return nil, ErrNotFound
+	}
+
+	return state, nil
+}
+
+func (d *boltJobDBService) SetContractConfig(
+	ctx context.Context,
+	config *model.JobContractConfig,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	config.JobID = model.ID(d.jobID)
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(d.bucket(tx, boltContractConfigBucket), boltContractConfigKey, config)
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to update a document")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltJobDBService) GetContractConfig(
+	ctx context.Context,
+) (*model.JobContractConfig, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var config *model.JobContractConfig
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		data := d.bucket(tx, boltContractConfigBucket).Get(boltContractConfigKey)
+		if data == nil {
+			return nil
+		}
+
+		config = new(model.JobContractConfig)
+		return json.Unmarshal(data, config)
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to query document")
+		return nil, err
+	} else if config == nil {
+		metrics.ReportFuncError(d.svcTags)
+		return nil, ErrNotFound
+	}
+
+	return config, nil
+}
+
+// pendingTransmissionKey orders pending transmissions by config digest, epoch and round.
+func pendingTransmissionKey(configDigest model.ID, ts model.ReportTimestamp) []byte {
+	return []byte(fmt.Sprintf("%s/%08x/%02x", configDigest, ts.Epoch, ts.Round))
+}
+
+func (d *boltJobDBService) InsertPendingTranmission(
+	ctx context.Context,
+	pendingTx *model.JobPendingTransmission,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	// ensure pending Tx is saved under correct Job ID
+	pendingTx.JobID = model.ID(d.jobID)
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		key := pendingTransmissionKey(pendingTx.ConfigDigest, pendingTx.ReportTimestamp)
+		return boltPut(d.bucket(tx, boltPendingTransmissionsBucket), key, pendingTx)
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltJobDBService) ListPendingTransmissions(
+	ctx context.Context,
+	configDigest model.ID,
+	cursor *model.Cursor,
+) ([]*model.JobPendingTransmission, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	pendingTransmissions := []*model.JobPendingTransmission{}
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		prefix := []byte(string(configDigest) + "/")
+		c := d.bucket(tx, boltPendingTransmissionsBucket).Cursor()
+
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var pendingTx model.JobPendingTransmission
+			if err := json.Unmarshal(v, &pendingTx); err != nil {
+				return err
+			}
+
+			pendingTransmissions = append(pendingTransmissions, &pendingTx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	sort.SliceStable(pendingTransmissions, func(i, j int) bool {
+		return pendingTransmissions[i].CreatedAt.Before(pendingTransmissions[j].CreatedAt)
+	})
+
+	return pendingTransmissions[:cursorLen(cursor, len(pendingTransmissions))], nil
+}
+
+func (d *boltJobDBService) DeletePendingTransmission(
+	ctx context.Context,
+	reportTimestamp model.ReportTimestamp,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	suffix := pendingTransmissionKey("", reportTimestamp)
+
+	err := d.deletePendingTransmissions(func(k []byte, _ *model.JobPendingTransmission) bool {
+		return bytes.HasSuffix(k, suffix)
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to delete documents")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltJobDBService) DeletePendingTransmissionsOlderThan(
+	ctx context.Context,
+	timestamp time.Time,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	err := d.deletePendingTransmissions(func(_ []byte, pendingTx *model.JobPendingTransmission) bool {
+		return pendingTx.Transmission.Time.Before(timestamp)
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to delete documents")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltJobDBService) deletePendingTransmissions(match func(k []byte, pendingTx *model.JobPendingTransmission) bool) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		bucket := d.bucket(tx, boltPendingTransmissionsBucket)
+
+		var keys [][]byte
+		if err := bucket.ForEach(func(k, v []byte) error {
+			var pendingTx model.JobPendingTransmission
+			if err := json.Unmarshal(v, &pendingTx); err != nil {
+				return err
+			}
+
+			if match(k, &pendingTx) {
+				keys = append(keys, append([]byte{}, k...))
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		// keys can't be deleted while iterating with ForEach
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *boltJobDBService) UpsertAnnouncement(
+	ctx context.Context,
+	ann *model.JobPeerAnnouncement,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	ann.JobID = model.ID(d.jobID)
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(d.bucket(tx, boltPeerAnnouncementsBucket), []byte(ann.PeerID), ann)
+	})
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	return nil
+}
+
+func (d *boltJobDBService) ListAnnouncements(
+	ctx context.Context,
+	peerIDs []string,
+	cursor *model.Cursor,
+) ([]*model.JobPeerAnnouncement, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	peerAnnouncements := []*model.JobPeerAnnouncement{}
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		bucket := d.bucket(tx, boltPeerAnnouncementsBucket)
+
+		for _, peerID := range peerIDs {
+			data := bucket.Get([]byte(peerID))
+			if data == nil {
+				continue
+			}
+
+			var ann model.JobPeerAnnouncement
+			if err := json.Unmarshal(data, &ann); err != nil {
+				return err
+			}
+
+			peerAnnouncements = append(peerAnnouncements, &ann)
+		}
+
+		return nil
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	sort.SliceStable(peerAnnouncements, func(i, j int) bool {
+		return peerAnnouncements[i].CreatedAt.Before(peerAnnouncements[j].CreatedAt)
+	})
+
+	return peerAnnouncements[:cursorLen(cursor, len(peerAnnouncements))], nil
+}
+
+func (d *boltJobDBService) ListPersistentStates(
+	ctx context.Context,
+) ([]*model.JobPersistentState, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	states := []*model.JobPersistentState{}
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		return d.bucket(tx, boltPersistentStatesBucket).ForEach(func(_, v []byte) error {
+			var state model.JobPersistentState
+			if err := json.Unmarshal(v, &state); err != nil {
+				return err
+			}
+
+			states = append(states, &state)
+			return nil
+		})
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	return states, nil
+}
+
+func (d *boltJobDBService) ListAllPendingTransmissions(
+	ctx context.Context,
+) ([]*model.JobPendingTransmission, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	pendingTransmissions := []*model.JobPendingTransmission{}
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		return d.bucket(tx, boltPendingTransmissionsBucket).ForEach(func(_, v []byte) error {
+			var pendingTx model.JobPendingTransmission
+			if err := json.Unmarshal(v, &pendingTx); err != nil {
+				return err
+			}
+
+			pendingTransmissions = append(pendingTransmissions, &pendingTx)
+			return nil
+		})
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	sort.SliceStable(pendingTransmissions, func(i, j int) bool {
+		return pendingTransmissions[i].CreatedAt.Before(pendingTransmissions[j].CreatedAt)
+	})
+
+	return pendingTransmissions, nil
+}
+
+func (d *boltJobDBService) ListAllAnnouncements(
+	ctx context.Context,
+) ([]*model.JobPeerAnnouncement, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	peerAnnouncements := []*model.JobPeerAnnouncement{}
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		return d.bucket(tx, boltPeerAnnouncementsBucket).ForEach(func(_, v []byte) error {
+			var ann model.JobPeerAnnouncement
+			if err := json.Unmarshal(v, &ann); err != nil {
+				return err
+			}
+
+			peerAnnouncements = append(peerAnnouncements, &ann)
+			return nil
+		})
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	sort.SliceStable(peerAnnouncements, func(i, j int) bool {
+		return peerAnnouncements[i].CreatedAt.Before(peerAnnouncements[j].CreatedAt)
+	})
+
+	return peerAnnouncements, nil
+}
@@ -23,6 +23,9 @@ func (d *jobDBService) SetContractConfig(
 	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
 	defer cancelFn()
 
+	// ensure the document is saved under correct Job ID
+	state.JobID = model.ID(d.jobID)
+
 	filter := bson.M{
 		"jobId": d.jobID,
 	}
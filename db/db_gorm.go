@@ -3,27 +3,34 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"net/url"
+	"time"
 
-	"github.com/InjectiveLabs/chainlink-injective/db/model"
 	"github.com/pkg/errors"
 	postgres_models "github.com/smartcontractkit/chainlink-relay/core/store/models"
+	log "github.com/xlab/suplog"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
 )
 
 type ExternalGorm interface {
-	CreateJob(ctx context.Context, job *model.Job) error
-	LoadJobs(ctx context.Context) ([]*model.Job, error)
-	DeleteJob(ctx context.Context, jobID string) error
+	Storage
 
 	Client() *gorm.DB
 	Connection() (*sql.DB, error)
-	String() string
 }
 
+var _ ExternalGorm = &externalGorm{}
+
 type externalGorm struct {
 	db *gorm.DB
+
+	svcTags metrics.Tags
 }
 
 func NewExternalPostgres(u *url.URL) (ExternalGorm, error) {
@@ -35,6 +42,10 @@ func NewExternalPostgres(u *url.URL) (ExternalGorm, error) {
 
 	e := &externalGorm{
 		db: db,
+
+		svcTags: metrics.Tags{
+			"svc": "db",
+		},
 	}
 
 	if err := e.migrate(); err != nil {
@@ -57,115 +68,199 @@ func (e *externalGorm) String() string {
 	return "DB Driver: gorm SQL"
 }
 
-func (e *externalGorm) migrate() error {
-	if err := e.db.AutoMigrate(&postgres_models.Job{}); err != nil {
-		return err
-	}
-	if err := e.db.AutoMigrate(&postgres_models.EncryptedKeyRings{}); err != nil {
-		return err
+func (e *externalGorm) Close() {
+	if sqlConn, err := e.db.DB(); err == nil {
+		_ = sqlConn.Close()
 	}
-	if err := e.db.AutoMigrate(&postgres_models.EthKeyStates{}); err != nil {
+}
+
+// gormJob is a row of ocr2_jobs, the job is stored as JSON
+// to keep the full spec, including data sources.
+type gormJob struct {
+	JobID     string    `gorm:"primaryKey"`
+	IsActive  bool      `gorm:"index"`
+	CreatedAt time.Time `gorm:"index"`
+	Data      string    `gorm:"type:text"`
+}
+
+func (gormJob) TableName() string {
+	return "ocr2_jobs"
+}
+
+func (e *externalGorm) migrate() error {
+	if err := e.db.AutoMigrate(
+		&gormJob{},
+		&gormPersistentState{},
+		&gormContractConfig{},
+		&gormPendingTransmission{},
+		&gormPeerAnnouncement{},
+	); err != nil {
 		return err
 	}
-	if err := e.db.AutoMigrate(&postgres_models.P2pPeers{}); err != nil {
+
+	if err := e.migrateLegacyJobs(); err != nil {
 		return err
 	}
-	if err := e.db.AutoMigrate(&postgres_models.Offchainreporting2ContractConfigs{}); err != nil {
-		return err
+
+	return e.migrateLegacyState()
+}
+
+// migrateLegacyJobs copies jobs from the Chainlink jobs table used by earlier versions,
+// once, if ocr2_jobs is still empty. OCR state of these jobs is copied by migrateLegacyState.
+func (e *externalGorm) migrateLegacyJobs() error {
+	if !e.db.Migrator().HasTable(&postgres_models.Job{}) {
+		return nil
 	}
-	if err := e.db.AutoMigrate(&postgres_models.Offchainreporting2DiscovererAnnouncements{}); err != nil {
+
+	var count int64
+	if err := e.db.Model(&gormJob{}).Count(&count).Error; err != nil {
 		return err
+	} else if count > 0 {
+		return nil
 	}
-	if err := e.db.AutoMigrate(&postgres_models.Offchainreporting2PersistentStates{}); err != nil {
+
+	var ormJobs []postgres_models.Job
+	if err := e.db.Find(&ormJobs).Error; err != nil {
+		err = errors.Wrap(err, "failed to query legacy jobs")
 		return err
 	}
-	if err := e.db.AutoMigrate(&postgres_models.Offchainreporting2PendingTransmissions{}); err != nil {
-		return err
+
+	for i := range ormJobs {
+		job := legacyOrmToJob(&ormJobs[i])
+
+		if err := e.UpsertJob(context.Background(), job); err != nil {
+			err = errors.Wrapf(err, "failed to migrate legacy job %s", job.JobID)
+			return err
+		}
+
+		log.WithField("jobID", job.JobID).Infoln("migrated job from the legacy jobs table")
 	}
 
 	return nil
 }
 
-// CreateJob saves the job data in the DB
-func (e *externalGorm) CreateJob(ctx context.Context, job *model.Job) error {
-	ormJob := jobToOrm(job)
+// UpsertJob saves the job data in the DB
+func (e *externalGorm) UpsertJob(ctx context.Context, job *model.Job) error {
+	metrics.ReportFuncCall(e.svcTags)
+	doneFn := metrics.ReportFuncTiming(e.svcTags)
+	defer doneFn()
 
-	if len(ormJob.JobID) == 0 {
+	if len(job.JobID) == 0 {
 		return errors.New("JobID cannot be empty")
 	}
 
-	return e.db.WithContext(ctx).Create(ormJob).Error
-}
+	data, err := json.Marshal(job)
+	if err != nil {
+		err = errors.Wrap(err, "failed to encode job")
+		return err
+	}
 
-// LoadJobs retrieves all jobs from the DB
-func (e *externalGorm) LoadJobs(ctx context.Context) ([]*model.Job, error) {
-	var ormJobs []postgres_models.Job
+	row := &gormJob{
+		JobID:     string(job.JobID),
+		IsActive:  job.IsActive,
+		CreatedAt: job.CreatedAt,
+		Data:      string(data),
+	}
 
-	err := e.db.WithContext(ctx).Find(&ormJobs).Error
+	err = e.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(row).Error
 	if err != nil {
-		err = errors.Wrapf(err, "failed to query jobs")
-		return nil, err
+		metrics.ReportFuncError(e.svcTags)
+		err = errors.Wrap(err, "failed to upsert a row")
+		return err
 	}
 
-	jobs := make([]*model.Job, 0, len(ormJobs))
-	for i := range ormJobs {
-		jobs = append(jobs, ormToJob(&ormJobs[i]))
-	}
+	return nil
+}
+
+// ListJobs retrieves active jobs from the DB
+func (e *externalGorm) ListJobs(ctx context.Context, cursor *model.Cursor) ([]*model.Job, error) {
+	metrics.ReportFuncCall(e.svcTags)
+	doneFn := metrics.ReportFuncTiming(e.svcTags)
+	defer doneFn()
 
-	return jobs, err
+	q := e.db.WithContext(ctx).Where("is_active = ?", true)
+	return e.listJobs(gormCursorLimit(q, cursor))
 }
 
-// loadJob retrieves a specific job from the DB
-func (e *externalGorm) loadJob(ctx context.Context, jobID string, discard bool) (*model.Job, error) {
-	var ormJob postgres_models.Job
+// ListAllJobs retrieves active and inactive jobs from the DB
+func (e *externalGorm) ListAllJobs(ctx context.Context) ([]*model.Job, error) {
+	metrics.ReportFuncCall(e.svcTags)
+	doneFn := metrics.ReportFuncTiming(e.svcTags)
+	defer doneFn()
+
+	return e.listJobs(e.db.WithContext(ctx))
+}
 
-	if err := e.db.WithContext(ctx).Where("job_id = ?", jobID).First(&ormJob).Error; err != nil {
+func (e *externalGorm) listJobs(q *gorm.DB) ([]*model.Job, error) {
+	var rows []gormJob
+
+	if err := q.Order("created_at").Find(&rows).Error; err != nil {
+		err = errors.Wrap(err, "failed to query jobs")
 		return nil, err
 	}
 
-	if discard {
-		return nil, nil
+	jobs := make([]*model.Job, 0, len(rows))
+	for _, row := range rows {
+		var job model.Job
+		if err := json.Unmarshal([]byte(row.Data), &job); err != nil {
+			err = errors.Wrapf(err, "failed to decode job %s", row.JobID)
+			return nil, err
+		}
+
+		jobs = append(jobs, &job)
 	}
 
-	return ormToJob(&ormJob), nil
+	return jobs, nil
 }
 
 // DeleteJob removes the job data from the DB
-func (e *externalGorm) DeleteJob(ctx context.Context, jobID string) error {
+func (e *externalGorm) DeleteJob(ctx context.Context, jobID model.ID) error {
+	metrics.ReportFuncCall(e.svcTags)
+	doneFn := metrics.ReportFuncTiming(e.svcTags)
+	defer doneFn()
+
 	if len(jobID) == 0 {
 		return errors.New("JobID cannot be empty")
 	}
 
-	job, err := e.loadJob(ctx, jobID, true)
+	err := e.db.WithContext(ctx).Where("job_id = ?", string(jobID)).Delete(&gormJob{}).Error
 	if err != nil {
-		err = errors.Wrapf(err, "failed to load job data for %s", jobID)
+		err = errors.Wrap(err, "failed to delete rows")
 		return err
 	}
 
-	return e.db.WithContext(ctx).Delete(job).Error
+	return nil
 }
 
-func jobToOrm(job *model.Job) *postgres_models.Job {
-	ormJob := &postgres_models.Job{
-		JobID:           string(job.JobID),
-		IsBootstrapPeer: job.Spec.IsBootstrapPeer,
-		ContractAddress: string(job.Spec.FeedID),
-		KeyBundleID:     string(job.Spec.KeyID),
+func (e *externalGorm) JobDBService(jobID string) (JobDBService, error) {
+	if len(jobID) == 0 {
+		return nil, errors.New("JobID cannot be empty")
+	}
+
+	j := &gormJobDBService{
+		db:    e.db,
+		jobID: jobID,
 
-		ContractConfigConfirmations:            uint16(job.Spec.ContractConfigConfirmations),
-		ContractConfigTrackerSubscribeInterval: job.Spec.ContractConfigTrackerSubscribeInterval,
-		ObservationTimeout:                     job.Spec.ObservationTimeout,
-		BlockchainTimeout:                      job.Spec.BlockchainTimeout,
+		svcTags: metrics.Tags{
+			"svc": "job_db",
+			"job": jobID,
+		},
 	}
 
-	for _, peer := range job.Spec.P2PBootstrapPeers {
-		ormJob.P2PBootstrapPeers = append(ormJob.P2PBootstrapPeers, peer)
+	return j, nil
+}
+
+func gormCursorLimit(q *gorm.DB, cursor *model.Cursor) *gorm.DB {
+	if limit := cursorLimit(cursor); limit > 0 {
+		return q.Limit(limit)
 	}
 
-	return ormJob
+	return q
 }
 
-func ormToJob(ormJob *postgres_models.Job) *model.Job {
+// legacyOrmToJob converts a job of the Chainlink jobs table. Jobs were deleted
+// from it on stop, so all of them are active.
+func legacyOrmToJob(ormJob *postgres_models.Job) *model.Job {
 	job := &model.Job{
 		JobID: model.ID(ormJob.JobID),
 		Spec: &model.JobSpec{
@@ -178,7 +273,8 @@ func ormToJob(ormJob *postgres_models.Job) *model.Job {
 			ObservationTimeout:                     ormJob.ObservationTimeout,
 			BlockchainTimeout:                      ormJob.BlockchainTimeout,
 		},
-		IsActive: true,
+		IsActive:  true,
+		CreatedAt: time.Now().UTC(),
 	}
 
 	for _, peer := range ormJob.P2PBootstrapPeers {
@@ -0,0 +1,471 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+// Rows of job state tables keep the documents as JSON, columns are only
+// used for keys and queries.
+
+type gormPersistentState struct {
+	JobID        string `gorm:"primaryKey"`
+	ConfigDigest string `gorm:"primaryKey"`
+	Data         string `gorm:"type:text"`
+}
+
+func (gormPersistentState) TableName() string {
+	return "ocr2_job_persistent_states"
+}
+
+type gormContractConfig struct {
+	JobID string `gorm:"primaryKey"`
+	Data  string `gorm:"type:text"`
+}
+
+func (gormContractConfig) TableName() string {
+	return "ocr2_job_contract_configs"
+}
+
+type gormPendingTransmission struct {
+	JobID        string    `gorm:"primaryKey"`
+	ConfigDigest string    `gorm:"primaryKey"`
+	Epoch        uint32    `gorm:"primaryKey;autoIncrement:false"`
+	Round        uint8     `gorm:"primaryKey;autoIncrement:false"`
+	TxTime       time.Time `gorm:"index"`
+	CreatedAt    time.Time
+	Data         string `gorm:"type:text"`
+}
+
+func (gormPendingTransmission) TableName() string {
+	return "ocr2_job_pending_transmissions"
+}
+
+type gormPeerAnnouncement struct {
+	JobID     string `gorm:"primaryKey"`
+	PeerID    string `gorm:"primaryKey"`
+	CreatedAt time.Time
+	Data      string `gorm:"type:text"`
+}
+
+func (gormPeerAnnouncement) TableName() string {
+	return "ocr2_job_peer_announcements"
+}
+
+var _ JobDBService = &gormJobDBService{}
+
+type gormJobDBService struct {
+	db    *gorm.DB
+	jobID string
+
+	svcTags metrics.Tags
+}
+
+func (d *gormJobDBService) JobID() model.ID {
+	return model.ID(d.jobID)
+}
+
+func (d *gormJobDBService) Close() {
+	return
+}
+
+func (d *gormJobDBService) upsert(ctx context.Context, row interface{}) error {
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(row).Error
+}
+
+func (d *gormJobDBService) SetPersistentState(
+	ctx context.Context,
+	state *model.JobPersistentState,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	state.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to encode persistent state")
+		return err
+	}
+
+	if err := d.upsert(ctx, &gormPersistentState{
+		JobID:        d.jobID,
+		ConfigDigest: string(state.ConfigDigest),
+		Data:         string(data),
+	}); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a row")
+		return err
+	}
+
+	return nil
+}
+
+func (d *gormJobDBService) GetPersistentState(
+	ctx context.Context,
+	configDigest model.ID,
+) (*model.JobPersistentState, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var row gormPersistentState
+
+	err := d.db.WithContext(ctx).
+		Where("job_id = ? AND config_digest = ?", d.jobID, string(configDigest)).
+		Take(&row).Error
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		err = errors.Wrap(err, "failed to query row")
+		return nil, err
+	}
+
+	var state model.JobPersistentState
+	if err := json.Unmarshal([]byte(row.Data), &state); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to decode persistent state")
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+func (d *gormJobDBService) SetContractConfig(
+	ctx context.Context,
+	config *model.JobContractConfig,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	config.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to encode contract config")
+		return err
+	}
+
+	if err := d.upsert(ctx, &gormContractConfig{
+		JobID: d.jobID,
+		Data:  string(data),
+	}); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to update a row")
+		return err
+	}
+
+	return nil
+}
+
+func (d *gormJobDBService) GetContractConfig(
+	ctx context.Context,
+) (*model.JobContractConfig, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var row gormContractConfig
+
+	err := d.db.WithContext(ctx).Where("job_id = ?", d.jobID).Take(&row).Error
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		err = errors.Wrap(err, "failed to query row")
+		return nil, err
+	}
+
+	var config model.JobContractConfig
+	if err := json.Unmarshal([]byte(row.Data), &config); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to decode contract config")
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func (d *gormJobDBService) InsertPendingTranmission(
+	ctx context.Context,
+	pendingTx *model.JobPendingTransmission,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	// ensure pending Tx is saved under correct Job ID
+	pendingTx.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(pendingTx)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to encode pending transmission")
+		return err
+	}
+
+	if err := d.upsert(ctx, &gormPendingTransmission{
+		JobID:        d.jobID,
+		ConfigDigest: string(pendingTx.ConfigDigest),
+		Epoch:        pendingTx.ReportTimestamp.Epoch,
+		Round:        pendingTx.ReportTimestamp.Round,
+		TxTime:       pendingTx.Transmission.Time,
+		CreatedAt:    pendingTx.CreatedAt,
+		Data:         string(data),
+	}); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a row")
+		return err
+	}
+
+	return nil
+}
+
+func (d *gormJobDBService) ListPendingTransmissions(
+	ctx context.Context,
+	configDigest model.ID,
+	cursor *model.Cursor,
+) ([]*model.JobPendingTransmission, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var rows []gormPendingTransmission
+
+	q := d.db.WithContext(ctx).
+		Where("job_id = ? AND config_digest = ?", d.jobID, string(configDigest)).
+		Order("created_at")
+	q = gormCursorLimit(q, cursor)
+
+	if err := q.Find(&rows).Error; err != nil {
+		err = errors.Wrap(err, "failed to query rows")
+		return nil, err
+	}
+
+	pendingTransmissions := make([]*model.JobPendingTransmission, 0, len(rows))
+	for _, row := range rows {
+		var pendingTx model.JobPendingTransmission
+		if err := json.Unmarshal([]byte(row.Data), &pendingTx); err != nil {
+			err = errors.Wrap(err, "failed to decode pending transmission")
+			return nil, err
+		}
+
+		pendingTransmissions = append(pendingTransmissions, &pendingTx)
+	}
+
+	return pendingTransmissions, nil
+}
+
+func (d *gormJobDBService) DeletePendingTransmission(
+	ctx context.Context,
+	reportTimestamp model.ReportTimestamp,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	err := d.db.WithContext(ctx).
+		Where("job_id = ? AND epoch = ? AND round = ?", d.jobID, reportTimestamp.Epoch, reportTimestamp.Round).
+		Delete(&gormPendingTransmission{}).Error
+	if err != nil {
+		err = errors.Wrap(err, "failed to delete rows")
+		return err
+	}
+
+	return nil
+}
+
+func (d *gormJobDBService) DeletePendingTransmissionsOlderThan(
+	ctx context.Context,
+	timestamp time.Time,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	err := d.db.WithContext(ctx).
+		Where("job_id = ? AND tx_time < ?", d.jobID, timestamp).
+		Delete(&gormPendingTransmission{}).Error
+	if err != nil {
+		err = errors.Wrap(err, "failed to delete rows")
+		return err
+	}
+
+	return nil
+}
+
+func (d *gormJobDBService) UpsertAnnouncement(
+	ctx context.Context,
+	ann *model.JobPeerAnnouncement,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	ann.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(ann)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to encode peer announcement")
+		return err
+	}
+
+	if err := d.upsert(ctx, &gormPeerAnnouncement{
+		JobID:     d.jobID,
+		PeerID:    string(ann.PeerID),
+		CreatedAt: ann.CreatedAt,
+		Data:      string(data),
+	}); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a row")
+		return err
+	}
+
+	return nil
+}
+
+func (d *gormJobDBService) ListAnnouncements(
+	ctx context.Context,
+	peerIDs []string,
+	cursor *model.Cursor,
+) ([]*model.JobPeerAnnouncement, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	if len(peerIDs) == 0 {
+		return []*model.JobPeerAnnouncement{}, nil
+	}
+
+	var rows []gormPeerAnnouncement
+
+	q := d.db.WithContext(ctx).
+		Where("job_id = ? AND peer_id IN ?", d.jobID, peerIDs).
+		Order("created_at")
+	q = gormCursorLimit(q, cursor)
+
+	if err := q.Find(&rows).Error; err != nil {
+		err = errors.Wrap(err, "failed to query rows")
+		return nil, err
+	}
+
+	peerAnnouncements := make([]*model.JobPeerAnnouncement, 0, len(rows))
+	for _, row := range rows {
+		var ann model.JobPeerAnnouncement
+		if err := json.Unmarshal([]byte(row.Data), &ann); err != nil {
+			err = errors.Wrap(err, "failed to decode peer announcement")
+			return nil, err
+		}
+
+		peerAnnouncements = append(peerAnnouncements, &ann)
+	}
+
+	return peerAnnouncements, nil
+}
+
+func (d *gormJobDBService) ListPersistentStates(
+	ctx context.Context,
+) ([]*model.JobPersistentState, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var rows []gormPersistentState
+
+	err := d.db.WithContext(ctx).Where("job_id = ?", d.jobID).Order("config_digest").Find(&rows).Error
+	if err != nil {
+		err = errors.Wrap(err, "failed to query rows")
+		return nil, err
+	}
+
+	states := make([]*model.JobPersistentState, 0, len(rows))
+	for _, row := range rows {
+		var state model.JobPersistentState
+		if err := json.Unmarshal([]byte(row.Data), &state); err != nil {
+			err = errors.Wrap(err, "failed to decode persistent state")
+			return nil, err
+		}
+
+		states = append(states, &state)
+	}
+
+	return states, nil
+}
+
+func (d *gormJobDBService) ListAllPendingTransmissions(
+	ctx context.Context,
+) ([]*model.JobPendingTransmission, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var rows []gormPendingTransmission
+
+	err := d.db.WithContext(ctx).Where("job_id = ?", d.jobID).Order("created_at").Find(&rows).Error
+	if err != nil {
+		err = errors.Wrap(err, "failed to query rows")
+		return nil, err
+	}
+
+	pendingTransmissions := make([]*model.JobPendingTransmission, 0, len(rows))
+	for _, row := range rows {
+		var pendingTx model.JobPendingTransmission
+		if err := json.Unmarshal([]byte(row.Data), &pendingTx); err != nil {
+			err = errors.Wrap(err, "failed to decode pending transmission")
+			return nil, err
+		}
+
+		pendingTransmissions = append(pendingTransmissions, &pendingTx)
+	}
+
+	return pendingTransmissions, nil
+}
+
+func (d *gormJobDBService) ListAllAnnouncements(
+	ctx context.Context,
+) ([]*model.JobPeerAnnouncement, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	var rows []gormPeerAnnouncement
+
+	err := d.db.WithContext(ctx).Where("job_id = ?", d.jobID).Order("created_at").Find(&rows).Error
+	if err != nil {
+		err = errors.Wrap(err, "failed to query rows")
+		return nil, err
+	}
+
+	peerAnnouncements := make([]*model.JobPeerAnnouncement, 0, len(rows))
+	for _, row := range rows {
+		var ann model.JobPeerAnnouncement
+		if err := json.Unmarshal([]byte(row.Data), &ann); err != nil {
+			err = errors.Wrap(err, "failed to decode peer announcement")
+			return nil, err
+		}
+
+		peerAnnouncements = append(peerAnnouncements, &ann)
+	}
+
+	return peerAnnouncements, nil
+}
@@ -0,0 +1,290 @@
+package db
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+	"gorm.io/gorm"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+// Earlier versions kept OCR state in the offchainreporting2_* tables of Chainlink,
+// all jobs under the same oracle spec ID.
+const (
+	legacyOracleSpecID = 1
+
+	legacyPersistentStatesTable        = "offchainreporting2_persistent_states"
+	legacyPendingTransmissionsTable    = "offchainreporting2_pending_transmissions"
+	legacyDiscovererAnnouncementsTable = "offchainreporting2_discoverer_announcements"
+)
+
+type legacyPersistentState struct {
+	ConfigDigest         string
+	Epoch                uint32
+	HighestSentEpoch     uint32
+	HighestReceivedEpoch string
+}
+
+type legacyPendingTransmission struct {
+	ConfigDigest         string
+	Epoch                uint32
+	Round                uint8
+	Time                 time.Time
+	ExtraHash            []byte
+	Report               []byte
+	AttributedSignatures string
+	CreatedAt            time.Time
+}
+
+type legacyAnnouncement struct {
+	RemotePeerID string
+	Ann          []byte
+	UpdatedAt    time.Time
+}
+
+// migrateLegacyState copies OCR state from the Chainlink tables used by earlier versions
+// to every job, once, if the job state tables are still empty. Persistent states and pending
+// transmissions are scoped by config digest, so a job never reads the state of another feed.
+// Contract configs are not copied, they are re-read from chain.
+//
+// Any failure fails the migration, so the oracle doesn't start from an empty state
+// and sign reports of epochs it has already signed.
+func (e *externalGorm) migrateLegacyState() error {
+	migrator := e.db.Migrator()
+	if !migrator.HasTable(legacyPersistentStatesTable) &&
+		!migrator.HasTable(legacyPendingTransmissionsTable) &&
+		!migrator.HasTable(legacyDiscovererAnnouncementsTable) {
+		return nil
+	}
+
+	for _, row := range []interface{}{&gormPersistentState{}, &gormPendingTransmission{}, &gormPeerAnnouncement{}} {
+		var count int64
+		if err := e.db.Model(row).Count(&count).Error; err != nil {
+			return err
+		} else if count > 0 {
+			return nil
+		}
+	}
+
+	var jobIDs []string
+	if err := e.db.Model(&gormJob{}).Order("job_id").Pluck("job_id", &jobIDs).Error; err != nil {
+		err = errors.Wrap(err, "failed to query jobs")
+		return err
+	}
+
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	states, err := e.readLegacyPersistentStates()
+	if err != nil {
+		err = errors.Wrap(err, "failed to read legacy persistent states")
+		return err
+	}
+
+	pendingTransmissions, err := e.readLegacyPendingTransmissions()
+	if err != nil {
+		err = errors.Wrap(err, "failed to read legacy pending transmissions")
+		return err
+	}
+
+	announcements, err := e.readLegacyAnnouncements()
+	if err != nil {
+		err = errors.Wrap(err, "failed to read legacy peer announcements")
+		return err
+	}
+
+	ctx := context.Background()
+
+	return e.db.Transaction(func(tx *gorm.DB) error {
+		for _, jobID := range jobIDs {
+			jobDB := &gormJobDBService{
+				db:      tx,
+				jobID:   jobID,
+				svcTags: e.svcTags,
+			}
+
+			for _, state := range states {
+				if err := jobDB.SetPersistentState(ctx, state); err != nil {
+					err = errors.Wrapf(err, "failed to migrate legacy persistent state of job %s", jobID)
+					return err
+				}
+			}
+
+			for _, pendingTx := range pendingTransmissions {
+				if err := jobDB.InsertPendingTranmission(ctx, pendingTx); err != nil {
+					err = errors.Wrapf(err, "failed to migrate legacy pending transmission of job %s", jobID)
+					return err
+				}
+			}
+
+			for _, ann := range announcements {
+				if err := jobDB.UpsertAnnouncement(ctx, ann); err != nil {
+					err = errors.Wrapf(err, "failed to migrate legacy peer announcement of job %s", jobID)
+					return err
+				}
+			}
+
+			log.WithFields(log.Fields{
+				"jobID":                jobID,
+				"persistentStates":     len(states),
+				"pendingTransmissions": len(pendingTransmissions),
+				"peerAnnouncements":    len(announcements),
+			}).Infoln("migrated OCR state from the legacy tables")
+		}
+
+		return nil
+	})
+}
+
+func (e *externalGorm) readLegacyPersistentStates() ([]*model.JobPersistentState, error) {
+	if !e.db.Migrator().HasTable(legacyPersistentStatesTable) {
+		return nil, nil
+	}
+
+	var rows []legacyPersistentState
+
+	err := e.db.Raw(`
+SELECT encode(config_digest, 'hex') AS config_digest, epoch, highest_sent_epoch,
+	COALESCE(array_to_json(highest_received_epoch)::text, '[]') AS highest_received_epoch
+FROM `+legacyPersistentStatesTable+`
+WHERE offchainreporting2_oracle_spec_id = ?
+ORDER BY config_digest`, legacyOracleSpecID).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*model.JobPersistentState, 0, len(rows))
+	for _, row := range rows {
+		state := &model.JobPersistentState{
+			ConfigDigest:     model.ID(row.ConfigDigest),
+			Epoch:            row.Epoch,
+			HighestSentEpoch: row.HighestSentEpoch,
+		}
+
+		if err := json.Unmarshal([]byte(row.HighestReceivedEpoch), &state.HighestReceivedEpoch); err != nil {
+			err = errors.Wrapf(err, "failed to decode highest received epochs of config digest %s", row.ConfigDigest)
+			return nil, err
+		}
+
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+func (e *externalGorm) readLegacyPendingTransmissions() ([]*model.JobPendingTransmission, error) {
+	if !e.db.Migrator().HasTable(legacyPendingTransmissionsTable) {
+		return nil, nil
+	}
+
+	var rows []legacyPendingTransmission
+
+	err := e.db.Raw(`
+SELECT encode(config_digest, 'hex') AS config_digest, epoch, round, time, extra_hash, report,
+	COALESCE((SELECT json_agg(encode(s, 'hex') ORDER BY i)
+		FROM unnest(attributed_signatures) WITH ORDINALITY AS sigs(s, i))::text, '[]') AS attributed_signatures,
+	created_at
+FROM `+legacyPendingTransmissionsTable+`
+WHERE offchainreporting2_oracle_spec_id = ?
+ORDER BY created_at`, legacyOracleSpecID).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pendingTransmissions := make([]*model.JobPendingTransmission, 0, len(rows))
+	for _, row := range rows {
+		pendingTx := &model.JobPendingTransmission{
+			ConfigDigest: model.ID(row.ConfigDigest),
+			ReportTimestamp: model.ReportTimestamp{
+				Epoch: row.Epoch,
+				Round: row.Round,
+			},
+			Transmission: model.PendingTransmission{
+				Time:      row.Time,
+				ExtraHash: model.HexBytes(row.ExtraHash),
+				Report:    model.HexBytes(row.Report),
+			},
+			CreatedAt: row.CreatedAt,
+		}
+
+		signatures, err := decodeLegacySignatures(row.AttributedSignatures)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to decode signatures of epoch %d round %d", row.Epoch, row.Round)
+			return nil, err
+		}
+
+		pendingTx.Transmission.AttributedSignatures = signatures
+		pendingTransmissions = append(pendingTransmissions, pendingTx)
+	}
+
+	return pendingTransmissions, nil
+}
+
+// decodeLegacySignatures decodes a JSON array of hex values, where each signature
+// is followed by its signer, an oracle ID of a single byte.
+func decodeLegacySignatures(data string) ([]model.AttributedOnchainSignature, error) {
+	var values []string
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		return nil, err
+	}
+
+	if len(values)%2 != 0 {
+		return nil, errors.Errorf("expected pairs of signatures and signers, got %d values", len(values))
+	}
+
+	signatures := make([]model.AttributedOnchainSignature, 0, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		signature, err := hex.DecodeString(values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		signer, err := hex.DecodeString(values[i+1])
+		if err != nil {
+			return nil, err
+		} else if len(signer) != 1 {
+			return nil, errors.Errorf("expected a single byte signer, got %d bytes", len(signer))
+		}
+
+		signatures = append(signatures, model.AttributedOnchainSignature{
+			Signature: model.HexBytes(signature),
+			Signer:    int(signer[0]),
+		})
+	}
+
+	return signatures, nil
+}
+
+func (e *externalGorm) readLegacyAnnouncements() ([]*model.JobPeerAnnouncement, error) {
+	if !e.db.Migrator().HasTable(legacyDiscovererAnnouncementsTable) {
+		return nil, nil
+	}
+
+	var rows []legacyAnnouncement
+
+	// announcements were stored per local peer ID, the latest one of a remote peer wins
+	err := e.db.Raw(`
+SELECT remote_peer_id, ann, updated_at
+FROM ` + legacyDiscovererAnnouncementsTable + `
+ORDER BY updated_at`).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	announcements := make([]*model.JobPeerAnnouncement, 0, len(rows))
+	for _, row := range rows {
+		announcements = append(announcements, &model.JobPeerAnnouncement{
+			PeerID:    model.ID(row.RemotePeerID),
+			Announce:  row.Ann,
+			CreatedAt: row.UpdatedAt,
+		})
+	}
+
+	return announcements, nil
+}
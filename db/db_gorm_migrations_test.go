@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+var _ = Describe("PostgreSQL migrations", func() {
+	pgURL := os.Getenv(testPostgresURLEnv)
+	if len(pgURL) == 0 {
+		It("is skipped", func() {
+			Skip(testPostgresURLEnv + " is not set")
+		})
+
+		return
+	}
+
+	var (
+		ctx     context.Context
+		u       *url.URL
+		storage ExternalGorm
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		u, err = url.ParseRequestURI(pgURL)
+		Expect(err).To(BeNil())
+
+		storage, err = NewExternalPostgres(u)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		_ = storage.Client().Migrator().DropTable(
+			&gormJob{},
+			&gormPersistentState{},
+			&gormContractConfig{},
+			&gormPendingTransmission{},
+			&gormPeerAnnouncement{},
+			legacyPersistentStatesTable,
+			legacyPendingTransmissionsTable,
+			legacyDiscovererAnnouncementsTable,
+		)
+		storage.Close()
+	})
+
+	It("copies OCR state of the legacy tables to every job", func() {
+		for _, jobID := range []model.ID{"job1", "job2"} {
+			Expect(storage.UpsertJob(ctx, &model.Job{
+				JobID:    jobID,
+				IsActive: true,
+			})).To(BeNil())
+		}
+
+		txTime := time.Now().UTC().Truncate(time.Second)
+
+		for _, stmt := range []string{
+			`CREATE TABLE offchainreporting2_persistent_states (
+				offchainreporting2_oracle_spec_id integer, config_digest bytea,
+				epoch bigint, highest_sent_epoch bigint, highest_received_epoch bigint[],
+				created_at timestamptz, updated_at timestamptz)`,
+			`CREATE TABLE offchainreporting2_pending_transmissions (
+				offchainreporting2_oracle_spec_id integer, config_digest bytea,
+				epoch bigint, round bigint, time timestamptz, extra_hash bytea, report bytea,
+				attributed_signatures bytea[], created_at timestamptz, updated_at timestamptz)`,
+			`CREATE TABLE offchainreporting2_discoverer_announcements (
+				local_peer_id text, remote_peer_id text, ann bytea,
+				created_at timestamptz, updated_at timestamptz)`,
+			`INSERT INTO offchainreporting2_persistent_states
+				VALUES (1, '\x0a0b'::bytea, 5, 4, '{3,4,5,5}', NOW(), NOW())`,
+			`INSERT INTO offchainreporting2_pending_transmissions
+				VALUES (1, '\x0a0b'::bytea, 4, 2, '` + txTime.Format(time.RFC3339) + `', '\x01'::bytea, '\x0203'::bytea,
+				ARRAY['\xaa'::bytea, '\x00'::bytea, '\xbb'::bytea, '\x02'::bytea], NOW(), NOW())`,
+			`INSERT INTO offchainreporting2_discoverer_announcements
+				VALUES ('local', 'remote', '\x0405'::bytea, NOW(), NOW())`,
+		} {
+			Expect(storage.Client().Exec(stmt).Error).To(BeNil())
+		}
+
+		migrated, err := NewExternalPostgres(u)
+		Expect(err).To(BeNil())
+		defer migrated.Close()
+
+		for _, jobID := range []string{"job1", "job2"} {
+			jobDB, err := migrated.JobDBService(jobID)
+			Expect(err).To(BeNil())
+
+			state, err := jobDB.GetPersistentState(ctx, "0a0b")
+			Expect(err).To(BeNil())
+			Expect(state.Epoch).To(Equal(uint32(5)))
+			Expect(state.HighestSentEpoch).To(Equal(uint32(4)))
+			Expect(state.HighestReceivedEpoch).To(Equal([]uint32{3, 4, 5, 5}))
+
+			pendingTxs, err := jobDB.ListPendingTransmissions(ctx, "0a0b", nil)
+			Expect(err).To(BeNil())
+			Expect(pendingTxs).To(HaveLen(1))
+			Expect(pendingTxs[0].ReportTimestamp).To(Equal(model.ReportTimestamp{Epoch: 4, Round: 2}))
+			Expect(pendingTxs[0].Transmission.Time.Equal(txTime)).To(BeTrue())
+			Expect(pendingTxs[0].Transmission.Report).To(Equal(model.HexBytes{0x02, 0x03}))
+			Expect(pendingTxs[0].Transmission.AttributedSignatures).To(Equal([]model.AttributedOnchainSignature{
+				{Signature: model.HexBytes{0xaa}, Signer: 0},
+				{Signature: model.HexBytes{0xbb}, Signer: 2},
+			}))
+
+			anns, err := jobDB.ListAnnouncements(ctx, []string{"remote"}, nil)
+			Expect(err).To(BeNil())
+			Expect(anns).To(HaveLen(1))
+			Expect(anns[0].Announce).To(Equal([]byte{0x04, 0x05}))
+		}
+
+		// the state is copied once, later changes are kept
+		Expect(migrated.Client().Exec(`DELETE FROM ocr2_job_pending_transmissions`).Error).To(BeNil())
+
+		reopened, err := NewExternalPostgres(u)
+		Expect(err).To(BeNil())
+		defer reopened.Close()
+
+		jobDB, err := reopened.JobDBService("job1")
+		Expect(err).To(BeNil())
+
+		pendingTxs, err := jobDB.ListPendingTransmissions(ctx, "0a0b", nil)
+		Expect(err).To(BeNil())
+		Expect(pendingTxs).To(BeEmpty())
+	})
+})
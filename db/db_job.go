@@ -20,6 +20,10 @@ func (d *dbService) UpsertJob(
 	doneFn := metrics.ReportFuncTiming(d.svcTags)
 	defer doneFn()
 
+	if len(job.JobID) == 0 {
+		return errors.New("JobID cannot be empty")
+	}
+
 	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
 	defer cancelFn()
 
@@ -76,13 +80,27 @@ func (d *dbService) ListJobs(
 	doneFn := metrics.ReportFuncTiming(d.svcTags)
 	defer doneFn()
 
+	return d.listJobs(ctx, bson.M{"isActive": true}, cursor)
+}
+
+func (d *dbService) ListAllJobs(
+	ctx context.Context,
+) ([]*model.Job, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	return d.listJobs(ctx, bson.M{}, nil)
+}
+
+func (d *dbService) listJobs(
+	ctx context.Context,
+	q bson.M,
+	cursor *model.Cursor,
+) ([]*model.Job, error) {
 	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
 	defer cancelFn()
 
-	q := bson.M{
-		"isActive": true,
-	}
-
 	opts := newFindOptionsWithCursor(cursor)
 	opts.SetSort(bson.M{
 		"createdAt": 1,
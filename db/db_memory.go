@@ -0,0 +1,559 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+var _ Storage = &memoryDBService{}
+
+// memoryDBService keeps everything in process memory, the state is lost on exit.
+// Records are copied in and out, so callers can't change stored data by accident.
+type memoryDBService struct {
+	mux       *sync.RWMutex
+	jobs      map[model.ID][]byte
+	jobStates map[string]*memoryJobState
+
+	svcTags metrics.Tags
+}
+
+type memoryJobState struct {
+	persistentStates     map[model.ID][]byte
+	contractConfig       []byte
+	pendingTransmissions map[memoryPendingTxKey][]byte
+	peerAnnouncements    map[model.ID][]byte
+}
+
+type memoryPendingTxKey struct {
+	ConfigDigest    model.ID
+	ReportTimestamp model.ReportTimestamp
+}
+
+// NewMemoryDBService returns an empty in-memory storage, for tests and ephemeral nodes.
+func NewMemoryDBService() Storage {
+	return &memoryDBService{
+		mux:       new(sync.RWMutex),
+		jobs:      make(map[model.ID][]byte),
+		jobStates: make(map[string]*memoryJobState),
+
+		svcTags: metrics.Tags{
+			"svc": "db",
+		},
+	}
+}
+
+func (d *memoryDBService) String() string {
+	return "DB Driver: in-memory"
+}
+
+func (d *memoryDBService) Close() {
+	return
+}
+
+func (d *memoryDBService) UpsertJob(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	if len(job.JobID) == 0 {
+		return errors.New("JobID cannot be empty")
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	d.mux.Lock()
+	d.jobs[job.JobID] = data
+	d.mux.Unlock()
+
+	return nil
+}
+
+func (d *memoryDBService) DeleteJob(
+	ctx context.Context,
+	jobID model.ID,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.Lock()
+	delete(d.jobs, jobID)
+	d.mux.Unlock()
+
+	return nil
+}
+
+func (d *memoryDBService) ListJobs(
+	ctx context.Context,
+	cursor *model.Cursor,
+) ([]*model.Job, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	return d.listJobs(cursor, true)
+}
+
+func (d *memoryDBService) ListAllJobs(
+	ctx context.Context,
+) ([]*model.Job, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	return d.listJobs(nil, false)
+}
+
+func (d *memoryDBService) listJobs(cursor *model.Cursor, activeOnly bool) ([]*model.Job, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	jobs := make([]*model.Job, 0, len(d.jobs))
+	for _, data := range d.jobs {
+		var job model.Job
+		if err := json.Unmarshal(data, &job); err != nil {
+			err = errors.Wrap(err, "failed to decode documents")
+			return nil, err
+		}
+
+		if job.IsActive || !activeOnly {
+			jobs = append(jobs, &job)
+		}
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool {
+		if jobs[i].CreatedAt.Equal(jobs[j].CreatedAt) {
+			return jobs[i].JobID < jobs[j].JobID
+		}
+
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt)
+	})
+
+	return jobs[:cursorLen(cursor, len(jobs))], nil
+}
+
+func (d *memoryDBService) JobDBService(jobID string) (JobDBService, error) {
+	if len(jobID) == 0 {
+		return nil, errors.New("JobID cannot be empty")
+	}
+
+	d.mux.Lock()
+	state, ok := d.jobStates[jobID]
+	if !ok {
+		state = &memoryJobState{
+			persistentStates:     make(map[model.ID][]byte),
+			pendingTransmissions: make(map[memoryPendingTxKey][]byte),
+			peerAnnouncements:    make(map[model.ID][]byte),
+		}
+
+		d.jobStates[jobID] = state
+	}
+	d.mux.Unlock()
+
+	j := &memoryJobDBService{
+		mux:   d.mux,
+		state: state,
+		jobID: jobID,
+
+		svcTags: metrics.Tags{
+			"svc": "job_db",
+			"job": jobID,
+		},
+	}
+
+	return j, nil
+}
+
+var _ JobDBService = &memoryJobDBService{}
+
+type memoryJobDBService struct {
+	mux   *sync.RWMutex
+	state *memoryJobState
+	jobID string
+
+	svcTags metrics.Tags
+}
+
+func (d *memoryJobDBService) JobID() model.ID {
+	return model.ID(d.jobID)
+}
+
+func (d *memoryJobDBService) Close() {
+	return
+}
+
+func (d *memoryJobDBService) SetPersistentState(
+	ctx context.Context,
+	state *model.JobPersistentState,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	state.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	d.mux.Lock()
+	d.state.persistentStates[state.ConfigDigest] = data
+	d.mux.Unlock()
+
+	return nil
+}
+
+func (d *memoryJobDBService) GetPersistentState(
+	ctx context.Context,
+	configDigest model.ID,
+) (*model.JobPersistentState, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.RLock()
+	data, ok := d.state.persistentStates[configDigest]
+	d.mux.RUnlock()
+
+	if !ok {
+		metrics.ReportFuncError(d.svcTags)
+		return nil, ErrNotFound
+	}
+
+	var state model.JobPersistentState
+	if err := json.Unmarshal(data, &state); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to query document")
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+func (d *memoryJobDBService) SetContractConfig(
+	ctx context.Context,
+	config *model.JobContractConfig,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	config.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to update a document")
+		return err
+	}
+
+	d.mux.Lock()
+	d.state.contractConfig = data
+	d.mux.Unlock()
+
+	return nil
+}
+
+func (d *memoryJobDBService) GetContractConfig(
+	ctx context.Context,
+) (*model.JobContractConfig, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.RLock()
+	data := d.state.contractConfig
+	d.mux.RUnlock()
+
+	if data == nil {
+		metrics.ReportFuncError(d.svcTags)
+		return nil, ErrNotFound
+	}
+
+	var config model.JobContractConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to query document")
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func (d *memoryJobDBService) InsertPendingTranmission(
+	ctx context.Context,
+	pendingTx *model.JobPendingTransmission,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	// ensure pending Tx is saved under correct Job ID
+	pendingTx.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(pendingTx)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	key := memoryPendingTxKey{
+		ConfigDigest:    pendingTx.ConfigDigest,
+		ReportTimestamp: pendingTx.ReportTimestamp,
+	}
+
+	d.mux.Lock()
+	d.state.pendingTransmissions[key] = data
+	d.mux.Unlock()
+
+	return nil
+}
+
+func (d *memoryJobDBService) ListPendingTransmissions(
+	ctx context.Context,
+	configDigest model.ID,
+	cursor *model.Cursor,
+) ([]*model.JobPendingTransmission, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	pendingTransmissions := []*model.JobPendingTransmission{}
+	for key, data := range d.state.pendingTransmissions {
+		if key.ConfigDigest != configDigest {
+			continue
+		}
+
+		var pendingTx model.JobPendingTransmission
+		if err := json.Unmarshal(data, &pendingTx); err != nil {
+			err = errors.Wrap(err, "failed to decode documents")
+			return nil, err
+		}
+
+		pendingTransmissions = append(pendingTransmissions, &pendingTx)
+	}
+
+	sort.SliceStable(pendingTransmissions, func(i, j int) bool {
+		a, b := pendingTransmissions[i], pendingTransmissions[j]
+		if a.CreatedAt.Equal(b.CreatedAt) {
+			return a.ReportTimestamp.Epoch < b.ReportTimestamp.Epoch ||
+				(a.ReportTimestamp.Epoch == b.ReportTimestamp.Epoch && a.ReportTimestamp.Round < b.ReportTimestamp.Round)
+		}
+
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+
+	return pendingTransmissions[:cursorLen(cursor, len(pendingTransmissions))], nil
+}
+
+func (d *memoryJobDBService) DeletePendingTransmission(
+	ctx context.Context,
+	reportTimestamp model.ReportTimestamp,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	for key := range d.state.pendingTransmissions {
+		if key.ReportTimestamp == reportTimestamp {
+			delete(d.state.pendingTransmissions, key)
+		}
+	}
+
+	return nil
+}
+
+func (d *memoryJobDBService) DeletePendingTransmissionsOlderThan(
+	ctx context.Context,
+	timestamp time.Time,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	for key, data := range d.state.pendingTransmissions {
+		var pendingTx model.JobPendingTransmission
+		if err := json.Unmarshal(data, &pendingTx); err != nil {
+			err = errors.Wrap(err, "failed to delete documents")
+			return err
+		}
+
+		if pendingTx.Transmission.Time.Before(timestamp) {
+			delete(d.state.pendingTransmissions, key)
+		}
+	}
+
+	return nil
+}
+
+func (d *memoryJobDBService) UpsertAnnouncement(
+	ctx context.Context,
+	ann *model.JobPeerAnnouncement,
+) error {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	ann.JobID = model.ID(d.jobID)
+
+	data, err := json.Marshal(ann)
+	if err != nil {
+		metrics.ReportFuncError(d.svcTags)
+		err = errors.Wrap(err, "failed to upsert a document")
+		return err
+	}
+
+	d.mux.Lock()
+	d.state.peerAnnouncements[ann.PeerID] = data
+	d.mux.Unlock()
+
+	return nil
+}
+
+func (d *memoryJobDBService) ListAnnouncements(
+	ctx context.Context,
+	peerIDs []string,
+	cursor *model.Cursor,
+) ([]*model.JobPeerAnnouncement, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	peerAnnouncements := []*model.JobPeerAnnouncement{}
+	for _, peerID := range peerIDs {
+		data, ok := d.state.peerAnnouncements[model.ID(peerID)]
+		if !ok {
+			continue
+		}
+
+		var ann model.JobPeerAnnouncement
+		if err := json.Unmarshal(data, &ann); err != nil {
+			err = errors.Wrap(err, "failed to decode documents")
+			return nil, err
+		}
+
+		peerAnnouncements = append(peerAnnouncements, &ann)
+	}
+
+	sort.SliceStable(peerAnnouncements, func(i, j int) bool {
+		return peerAnnouncements[i].CreatedAt.Before(peerAnnouncements[j].CreatedAt)
+	})
+
+	return peerAnnouncements[:cursorLen(cursor, len(peerAnnouncements))], nil
+}
+
+func (d *memoryJobDBService) ListPersistentStates(
+	ctx context.Context,
+) ([]*model.JobPersistentState, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	states := make([]*model.JobPersistentState, 0, len(d.state.persistentStates))
+	for _, data := range d.state.persistentStates {
+		var state model.JobPersistentState
+		if err := json.Unmarshal(data, &state); err != nil {
+			err = errors.Wrap(err, "failed to decode documents")
+			return nil, err
+		}
+
+		states = append(states, &state)
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ConfigDigest < states[j].ConfigDigest
+	})
+
+	return states, nil
+}
+
+func (d *memoryJobDBService) ListAllPendingTransmissions(
+	ctx context.Context,
+) ([]*model.JobPendingTransmission, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	pendingTransmissions := make([]*model.JobPendingTransmission, 0, len(d.state.pendingTransmissions))
+	for _, data := range d.state.pendingTransmissions {
+		var pendingTx model.JobPendingTransmission
+		if err := json.Unmarshal(data, &pendingTx); err != nil {
+			err = errors.Wrap(err, "failed to decode documents")
+			return nil, err
+		}
+
+		pendingTransmissions = append(pendingTransmissions, &pendingTx)
+	}
+
+	sort.SliceStable(pendingTransmissions, func(i, j int) bool {
+		return pendingTransmissions[i].CreatedAt.Before(pendingTransmissions[j].CreatedAt)
+	})
+
+	return pendingTransmissions, nil
+}
+
+func (d *memoryJobDBService) ListAllAnnouncements(
+	ctx context.Context,
+) ([]*model.JobPeerAnnouncement, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	peerAnnouncements := make([]*model.JobPeerAnnouncement, 0, len(d.state.peerAnnouncements))
+	for _, data := range d.state.peerAnnouncements {
+		var ann model.JobPeerAnnouncement
+		if err := json.Unmarshal(data, &ann); err != nil {
+			err = errors.Wrap(err, "failed to decode documents")
+			return nil, err
+		}
+
+		peerAnnouncements = append(peerAnnouncements, &ann)
+	}
+
+	sort.SliceStable(peerAnnouncements, func(i, j int) bool {
+		return peerAnnouncements[i].CreatedAt.Before(peerAnnouncements[j].CreatedAt)
+	})
+
+	return peerAnnouncements, nil
+}
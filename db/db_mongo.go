@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/InjectiveLabs/chainlink-injective/db/dbconn"
@@ -14,13 +13,11 @@ import (
 )
 
 type DBService interface {
-	JobCollection
+	Storage
 
 	DBName() string
 	Client() *mongo.Client
 	Connection() dbconn.Conn
-	String() string
-	Close()
 }
 
 var _ DBService = &dbService{}
@@ -40,6 +37,11 @@ type JobCollection interface {
 		ctx context.Context,
 		cursor *model.Cursor,
 	) ([]*model.Job, error)
+
+	// ListAllJobs lists active and inactive jobs, for backups.
+	ListAllJobs(
+		ctx context.Context,
+	) ([]*model.Job, error)
 }
 
 type JobDBService interface {
@@ -49,8 +51,6 @@ type JobDBService interface {
 	PeerAnnouncementCollection
 
 	JobID() model.ID
-	DBName() string
-	Client() *mongo.Client
 	Close()
 }
 
@@ -66,6 +66,11 @@ type PersistentStateCollection interface {
 		ctx context.Context,
 		configDigest model.ID,
 	) (*model.JobPersistentState, error)
+
+	// ListPersistentStates lists states of all config digests, for backups.
+	ListPersistentStates(
+		ctx context.Context,
+	) ([]*model.JobPersistentState, error)
 }
 
 type ContractConfigCollection interface {
@@ -91,6 +96,8 @@ type PendingTransmissionCollection interface {
 		cursor *model.Cursor,
 	) ([]*model.JobPendingTransmission, error)
 
+	// DeletePendingTransmission deletes the pending transmissions with the report timestamp
+	// under any config digest, as libocr doesn't pass the digest on deletion.
 	DeletePendingTransmission(
 		ctx context.Context,
 		reportTimestamp model.ReportTimestamp,
@@ -100,6 +107,11 @@ type PendingTransmissionCollection interface {
 		ctx context.Context,
 		timestamp time.Time,
 	) error
+
+	// ListAllPendingTransmissions lists pending transmissions of all config digests, for backups.
+	ListAllPendingTransmissions(
+		ctx context.Context,
+	) ([]*model.JobPendingTransmission, error)
 }
 
 type PeerAnnouncementCollection interface {
@@ -113,8 +125,14 @@ type PeerAnnouncementCollection interface {
 		peerIDs []string,
 		cursor *model.Cursor,
 	) ([]*model.JobPeerAnnouncement, error)
+
+	// ListAllAnnouncements lists announcements of all peers, for backups.
+	ListAllAnnouncements(
+		ctx context.Context,
+	) ([]*model.JobPeerAnnouncement, error)
 }
 
+// NewDBService expects the schema to be up to date, see MigrateMongo.
 func NewDBService(
 	conn dbconn.Conn,
 ) (DBService, error) {
@@ -127,8 +145,6 @@ func NewDBService(
 		},
 	}
 
-	d.ensureIndex()
-
 	return d, nil
 }
 
@@ -159,14 +175,12 @@ func (d *dbService) Close() {
 	return
 }
 
-func (d *dbService) jobCollection() *mongo.Collection {
-	return d.db.Database(d.conn.DatabaseName()).Collection("jobs")
+func (d *dbService) JobDBService(jobID string) (JobDBService, error) {
+	return NewJobDBService(d.conn, jobID)
 }
 
-func (d *dbService) ensureIndex() {
-	_, _ = d.jobCollection().Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
-		dbconn.MakeIndex(true, bson.D{{"jobId", 1}}),
-	})
+func (d *dbService) jobCollection() *mongo.Collection {
+	return d.db.Database(d.conn.DatabaseName()).Collection("jobs")
 }
 
 func NewJobDBService(
@@ -184,8 +198,6 @@ func NewJobDBService(
 		},
 	}
 
-	d.ensureIndex()
-
 	return d, nil
 }
 
@@ -236,28 +248,3 @@ func (d *jobDBService) pendingTransmissionCollection() *mongo.Collection {
 func (d *jobDBService) peerAnnouncementCollection() *mongo.Collection {
 	return d.db.Database(d.conn.DatabaseName()).Collection("job_peer_announcements")
 }
-
-func (d *jobDBService) ensureIndex() {
-	_, _ = d.persistentStateCollection().Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
-		dbconn.MakeIndex(true, bson.D{{"jobId", 1}}),
-		dbconn.MakeIndex(false, bson.D{{"configDigest", 1}}),
-		dbconn.MakeIndex(false, bson.D{{"isActive", 1}}),
-	})
-
-	_, _ = d.contractConfigCollection().Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
-		dbconn.MakeIndex(true, bson.D{{"jobId", 1}}),
-		dbconn.MakeIndex(true, bson.D{{"jobId", 1}, {"configDigest", 1}}),
-	})
-
-	_, _ = d.pendingTransmissionCollection().Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
-		dbconn.MakeIndex(false, bson.D{{"jobId", 1}}),
-		dbconn.MakeIndex(false, bson.D{{"configDigest", 1}}),
-		dbconn.MakeIndex(true, bson.D{{"reportTimestamp.epoch", 1}, {"reportTimestamp.round", 1}}),
-		dbconn.MakeIndex(false, bson.D{{"tx.createdAt", 1}}),
-	})
-
-	_, _ = d.peerAnnouncementCollection().Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
-		dbconn.MakeIndex(false, bson.D{{"jobId", 1}}),
-		dbconn.MakeIndex(true, bson.D{{"jobId", 1}, {"peerId", 1}}),
-	})
-}
@@ -0,0 +1,230 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/dbconn"
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+// MongoMigration is a numbered schema change of MongoDB. Migrations must be idempotent,
+// so a migration interrupted midway can be applied again.
+type MongoMigration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+}
+
+const schemaMigrationsCollection = "schema_migrations"
+
+// mongoMigrations are applied in order of versions. Never change or remove
+// released migrations, add new ones instead.
+var mongoMigrations = []*MongoMigration{
+	{
+		Version:     1,
+		Description: "create indexes, scope unique indexes of job state by job ID and config digest",
+		Up:          migrateMongoScopeIndexes,
+	},
+}
+
+// MigrateMongo applies pending migrations and returns the applied ones.
+func MigrateMongo(ctx context.Context, conn dbconn.Conn) ([]*model.SchemaMigration, error) {
+	db := mongoDatabase(conn)
+
+	status, err := mongoMigrationStatus(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]*model.SchemaMigration, 0, len(mongoMigrations))
+
+	for _, migration := range mongoMigrations {
+		if record, ok := status[migration.Version]; ok && record.AppliedAt != nil {
+			continue
+		}
+
+		if err := migration.Up(ctx, db); err != nil {
+			err = errors.Wrapf(err, "migration %d failed", migration.Version)
+			return applied, err
+		}
+
+		appliedAt := time.Now().UTC()
+		record := &model.SchemaMigration{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   &appliedAt,
+		}
+
+		opts := &options.UpdateOptions{}
+		opts.SetUpsert(true)
+
+		_, err := db.Collection(schemaMigrationsCollection).UpdateOne(ctx, bson.M{
+			"version": migration.Version,
+		}, bson.M{
+			"$set": record,
+		}, opts)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to record migration %d", migration.Version)
+			return applied, err
+		}
+
+		applied = append(applied, record)
+	}
+
+	return applied, nil
+}
+
+// MongoMigrationStatus lists all known migrations, applied ones have AppliedAt set.
+// Migrations applied by a newer version of the app are listed as well.
+func MongoMigrationStatus(ctx context.Context, conn dbconn.Conn) ([]*model.SchemaMigration, error) {
+	status, err := mongoMigrationStatus(ctx, mongoDatabase(conn))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range mongoMigrations {
+		if _, ok := status[migration.Version]; !ok {
+			status[migration.Version] = &model.SchemaMigration{
+				Version:     migration.Version,
+				Description: migration.Description,
+			}
+		}
+	}
+
+	result := make([]*model.SchemaMigration, 0, len(status))
+	for _, record := range status {
+		result = append(result, record)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+
+	return result, nil
+}
+
+// mongoMigrationStatus returns records of applied migrations by version.
+func mongoMigrationStatus(ctx context.Context, db *mongo.Database) (map[int]*model.SchemaMigration, error) {
+	collection := db.Collection(schemaMigrationsCollection)
+
+	if _, err := collection.Indexes().CreateOne(ctx, dbconn.MakeIndex(true, bson.D{{"version", 1}})); err != nil {
+		err = errors.Wrap(err, "failed to create schema_migrations index")
+		return nil, err
+	}
+
+	cur, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		err = errors.Wrap(err, "failed to query documents")
+		return nil, err
+	}
+
+	var records []*model.SchemaMigration
+	if err := cur.All(ctx, &records); err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	status := make(map[int]*model.SchemaMigration, len(records))
+	for _, record := range records {
+		status[record.Version] = record
+	}
+
+	return status, nil
+}
+
+func mongoDatabase(conn dbconn.Conn) *mongo.Database {
+	return conn.Backend().(*mongo.Client).Database(conn.DatabaseName())
+}
+
+// migrateMongoScopeIndexes replaces indexes created before migrations were introduced.
+// Unique indexes on jobId alone in job_persistent_states and on the report timestamp alone
+// in job_pending_transmissions made jobs collide with each other, and across config digests.
+func migrateMongoScopeIndexes(ctx context.Context, db *mongo.Database) error {
+	drop := map[string][]string{
+		"job_persistent_states": {
+			"jobId_1",
+			"isActive_1",
+		},
+		"job_contract_configs": {
+			"jobId_1_configDigest_1",
+		},
+		"job_pending_transmissions": {
+			"reportTimestamp.epoch_1_reportTimestamp.round_1",
+			"tx.createdAt_1",
+			"tx.time_1",
+		},
+	}
+
+	for collection, names := range drop {
+		for _, name := range names {
+			if err := dropMongoIndexIfExists(ctx, db.Collection(collection), name); err != nil {
+				return err
+			}
+		}
+	}
+
+	create := map[string][]mongo.IndexModel{
+		"jobs": {
+			dbconn.MakeIndex(true, bson.D{{"jobId", 1}}),
+			dbconn.MakeIndex(false, bson.D{{"isActive", 1}, {"createdAt", 1}}),
+		},
+		"job_persistent_states": {
+			dbconn.MakeIndex(true, bson.D{{"jobId", 1}, {"configDigest", 1}}),
+		},
+		"job_contract_configs": {
+			dbconn.MakeIndex(true, bson.D{{"jobId", 1}}),
+		},
+		"job_pending_transmissions": {
+			dbconn.MakeIndex(false, bson.D{{"jobId", 1}}),
+			dbconn.MakeIndex(false, bson.D{{"configDigest", 1}}),
+			dbconn.MakeIndex(true, bson.D{
+				{"jobId", 1},
+				{"configDigest", 1},
+				{"reportTimestamp.epoch", 1},
+				{"reportTimestamp.round", 1},
+			}),
+			dbconn.MakeIndex(false, bson.D{{"jobId", 1}, {"tx.time", 1}}),
+		},
+		"job_peer_announcements": {
+			dbconn.MakeIndex(false, bson.D{{"jobId", 1}}),
+			dbconn.MakeIndex(true, bson.D{{"jobId", 1}, {"peerId", 1}}),
+		},
+	}
+
+	for collection, indexes := range create {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes); err != nil {
+			err = errors.Wrapf(err, "failed to create indexes of %s", collection)
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	mongoErrNamespaceNotFound = 26
+	mongoErrIndexNotFound     = 27
+)
+
+func dropMongoIndexIfExists(ctx context.Context, collection *mongo.Collection, name string) error {
+	_, err := collection.Indexes().DropOne(ctx, name)
+	if err == nil {
+		return nil
+	}
+
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) &&
+		(cmdErr.Code == mongoErrIndexNotFound || cmdErr.Code == mongoErrNamespaceNotFound) {
+		return nil
+	}
+
+	err = errors.Wrapf(err, "failed to drop index %s of %s", name, collection.Name())
+	return err
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/dbconn"
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+var _ = Describe("MongoDB migrations", func() {
+	connection := os.Getenv(testMongoConnectionEnv)
+	if len(connection) == 0 {
+		It("is skipped", func() {
+			Skip(testMongoConnectionEnv + " is not set")
+		})
+
+		return
+	}
+
+	var (
+		ctx  context.Context
+		conn dbconn.Conn
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		conn, err = dbconn.NewMongoConn(ctx, &dbconn.MongoConfig{
+			Connection: connection,
+			Database:   fmt.Sprintf("ocr2_test_%d", time.Now().UnixNano()),
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		_ = mongoDatabase(conn).Drop(ctx)
+		_ = conn.Close()
+	})
+
+	It("applies pending migrations once", func() {
+		status, err := MongoMigrationStatus(ctx, conn)
+		Expect(err).To(BeNil())
+		Expect(status).To(HaveLen(len(mongoMigrations)))
+		Expect(status[0].AppliedAt).To(BeNil())
+
+		applied, err := MigrateMongo(ctx, conn)
+		Expect(err).To(BeNil())
+		Expect(applied).To(HaveLen(len(mongoMigrations)))
+
+		applied, err = MigrateMongo(ctx, conn)
+		Expect(err).To(BeNil())
+		Expect(applied).To(BeEmpty())
+
+		status, err = MongoMigrationStatus(ctx, conn)
+		Expect(err).To(BeNil())
+		for _, migration := range status {
+			Expect(migration.AppliedAt).ToNot(BeNil())
+		}
+	})
+
+	It("scopes indexes of pending transmissions created before migrations", func() {
+		_, err := mongoDatabase(conn).Collection("job_pending_transmissions").Indexes().CreateOne(ctx,
+			dbconn.MakeIndex(true, bson.D{{"reportTimestamp.epoch", 1}, {"reportTimestamp.round", 1}}),
+		)
+		Expect(err).To(BeNil())
+
+		_, err = MigrateMongo(ctx, conn)
+		Expect(err).To(BeNil())
+
+		for _, jobID := range []string{"job1", "job2"} {
+			jobDB, err := NewJobDBService(conn, jobID)
+			Expect(err).To(BeNil())
+
+			Expect(jobDB.InsertPendingTranmission(ctx, &model.JobPendingTransmission{
+				ConfigDigest:    "digest1",
+				ReportTimestamp: model.ReportTimestamp{Epoch: 1, Round: 1},
+				CreatedAt:       time.Now().UTC(),
+			})).To(BeNil())
+		}
+	})
+})
@@ -23,6 +23,9 @@ func (d *jobDBService) UpsertAnnouncement(
 	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
 	defer cancelFn()
 
+	// ensure the document is saved under correct Job ID
+	ann.JobID = model.ID(d.jobID)
+
 	filter := bson.M{
 		"jobId":  d.jobID,
 		"peerId": ann.PeerID,
@@ -87,3 +90,35 @@ func (d *jobDBService) ListAnnouncements(
 
 	return peerAnnouncements, nil
 }
+
+func (d *jobDBService) ListAllAnnouncements(
+	ctx context.Context,
+) ([]*model.JobPeerAnnouncement, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
+	defer cancelFn()
+
+	q := bson.M{
+		"jobId": d.jobID,
+	}
+
+	opts := &options.FindOptions{}
+	opts.SetSort(bson.D{{"createdAt", 1}})
+
+	cur, err := d.peerAnnouncementCollection().Find(dbCtx, q, opts)
+	if err != nil {
+		err = errors.Wrap(err, "failed to query documents")
+		return nil, err
+	}
+
+	var result []*model.JobPeerAnnouncement
+	if err := cur.All(dbCtx, &result); err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -28,8 +28,19 @@ func (d *jobDBService) InsertPendingTranmission(
 	// ensure pending Tx is saved under correct Job ID
 	pendingTx.JobID = model.ID(d.jobID)
 
-	opts := &options.InsertOneOptions{}
-	_, err := d.pendingTransmissionCollection().InsertOne(dbCtx, pendingTx, opts)
+	filter := bson.M{
+		"jobId":           d.jobID,
+		"configDigest":    pendingTx.ConfigDigest,
+		"reportTimestamp": pendingTx.ReportTimestamp,
+	}
+
+	opts := &options.UpdateOptions{}
+	opts.SetUpsert(true)
+	upd := bson.M{
+		"$set": pendingTx,
+	}
+
+	_, err := d.pendingTransmissionCollection().UpdateOne(dbCtx, filter, upd, opts)
 	if err != nil {
 		metrics.ReportFuncError(d.svcTags)
 		err = errors.Wrap(err, "failed to upsert a document")
@@ -97,8 +108,9 @@ func (d *jobDBService) DeletePendingTransmission(
 		"reportTimestamp": reportTimestamp,
 	}
 
+	// the same report timestamp may be pending under several config digests
 	opts := &options.DeleteOptions{}
-	_, err := d.pendingTransmissionCollection().DeleteOne(dbCtx, q, opts)
+	_, err := d.pendingTransmissionCollection().DeleteMany(dbCtx, q, opts)
 	if err != nil {
 		err = errors.Wrap(err, "failed to delete documents")
 		return err
@@ -120,7 +132,7 @@ func (d *jobDBService) DeletePendingTransmissionsOlderThan(
 
 	q := bson.M{
 		"jobId": d.jobID,
-		"tx.createdAt": bson.M{
+		"tx.time": bson.M{
 			"$lt": primitive.NewDateTimeFromTime(timestamp),
 		},
 	}
@@ -134,3 +146,35 @@ func (d *jobDBService) DeletePendingTransmissionsOlderThan(
 
 	return nil
 }
+
+func (d *jobDBService) ListAllPendingTransmissions(
+	ctx context.Context,
+) ([]*model.JobPendingTransmission, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
+	defer cancelFn()
+
+	q := bson.M{
+		"jobId": d.jobID,
+	}
+
+	opts := &options.FindOptions{}
+	opts.SetSort(bson.D{{"createdAt", 1}})
+
+	cur, err := d.pendingTransmissionCollection().Find(dbCtx, q, opts)
+	if err != nil {
+		err = errors.Wrap(err, "failed to query documents")
+		return nil, err
+	}
+
+	var result []*model.JobPendingTransmission
+	if err := cur.All(dbCtx, &result); err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -23,6 +23,9 @@ func (d *jobDBService) SetPersistentState(
 	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
 	defer cancelFn()
 
+	// ensure the document is saved under correct Job ID
+	state.JobID = model.ID(d.jobID)
+
 	filter := bson.M{
 		"jobId":        d.jobID,
 		"configDigest": state.ConfigDigest,
@@ -77,3 +80,35 @@ func (d *jobDBService) GetPersistentState(
 
 	return &state, err
 }
+
+func (d *jobDBService) ListPersistentStates(
+	ctx context.Context,
+) ([]*model.JobPersistentState, error) {
+	metrics.ReportFuncCall(d.svcTags)
+	doneFn := metrics.ReportFuncTiming(d.svcTags)
+	defer doneFn()
+
+	dbCtx, cancelFn := context.WithTimeout(ctx, defaultQueryTimeout)
+	defer cancelFn()
+
+	q := bson.M{
+		"jobId": d.jobID,
+	}
+
+	opts := &options.FindOptions{}
+	opts.SetSort(bson.D{{"configDigest", 1}})
+
+	cur, err := d.persistentStateCollection().Find(dbCtx, q, opts)
+	if err != nil {
+		err = errors.Wrap(err, "failed to query documents")
+		return nil, err
+	}
+
+	var result []*model.JobPersistentState
+	if err := cur.All(dbCtx, &result); err != nil {
+		err = errors.Wrap(err, "failed to decode documents")
+		return nil, err
+	}
+
+	return result, nil
+}
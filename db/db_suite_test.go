@@ -0,0 +1,18 @@
+package db
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/xlab/suplog"
+)
+
+func TestDB(t *testing.T) {
+	if !testing.Verbose() {
+		log.DefaultLogger.SetLevel(log.FatalLevel)
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DB Engines Test Suite")
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,6 +31,111 @@ type JobSpec struct {
 	ContractConfigTrackerSubscribeInterval string   `json:"contractConfigTrackerSubscribeInterval" bson:"contractConfigTrackerSubscribeInterval"`
 	ObservationTimeout                     string   `json:"observationTimeout" bson:"observationTimeout"`
 	BlockchainTimeout                      string   `json:"blockchainTimeout" bson:"blockchainTimeout"`
+
+	// TransmitterAddress pins the transmitter account of the job, it must be in the node's
+	// transmitter pool. Assigned automatically if not set.
+	TransmitterAddress string `json:"transmitterAddress,omitempty" bson:"transmitterAddress,omitempty"`
+
+	// DataSource selects where job observations come from. Jobs without
+	// a data source spec are triggered via the Chainlink node webhook.
+	DataSource *DataSourceSpec `json:"dataSource,omitempty" bson:"dataSource,omitempty"`
+
+	// DataSources are queried concurrently and medianized into a single
+	// observation. Mutually exclusive with DataSource.
+	DataSources []*DataSourceSpec `json:"dataSources,omitempty" bson:"dataSources,omitempty"`
+	// DataSourcesMinResponses is the minimum number of sources that must respond
+	// before the deadline for the observation to succeed. Defaults to 1.
+	DataSourcesMinResponses int `json:"dataSourcesMinResponses,omitempty" bson:"dataSourcesMinResponses,omitempty"`
+	// DataSourcesMaxDeviation drops responses that deviate from the median by more than
+	// the given fraction, e.g. "0.05" for 5%. Outliers are kept if not set.
+	DataSourcesMaxDeviation string `json:"dataSourcesMaxDeviation,omitempty" bson:"dataSourcesMaxDeviation,omitempty"`
+
+	// JuelsPerFeeCoinSource observes the amount of juels (LINK base units) per 1 INJ,
+	// carried in reports for the reimbursements on chains supporting it. Zero is observed if not set.
+	JuelsPerFeeCoinSource *DataSourceSpec `json:"juelsPerFeeCoinSource,omitempty" bson:"juelsPerFeeCoinSource,omitempty"`
+	// JuelsPerFeeCoinFallback is observed when the source fails and there is no recent
+	// value to reuse. Defaults to zero.
+	JuelsPerFeeCoinFallback string `json:"juelsPerFeeCoinFallback,omitempty" bson:"juelsPerFeeCoinFallback,omitempty"`
+}
+
+type DataSourceType string
+
+const (
+	// DataSourceWebhook triggers the Chainlink node job and waits for the result on POST /runs.
+	DataSourceWebhook DataSourceType = "webhook"
+	// DataSourceHTTP fetches a JSON document and extracts the value with a jq path.
+	DataSourceHTTP DataSourceType = "http"
+	// DataSourceStatic always observes a fixed value, useful for fixtures and testing.
+	DataSourceStatic DataSourceType = "static"
+)
+
+type DataSourceSpec struct {
+	Type DataSourceType `json:"type" bson:"type"`
+	// Name identifies the source in logs and metrics, optional.
+	Name string `json:"name,omitempty" bson:"name,omitempty"`
+	// Timeout limits the time of a single query, optional.
+	Timeout string `json:"timeout,omitempty" bson:"timeout,omitempty"`
+
+	// HTTP source params
+	URL        string            `json:"url,omitempty" bson:"url,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty" bson:"headers,omitempty"`
+	Path       string            `json:"path,omitempty" bson:"path,omitempty"`
+	Multiplier string            `json:"multiplier,omitempty" bson:"multiplier,omitempty"`
+
+	// Webhook source params
+	MaxAge string `json:"maxAge,omitempty" bson:"maxAge,omitempty"`
+
+	// Static source params
+	Value string `json:"value,omitempty" bson:"value,omitempty"`
+}
+
+// JobStatus is a snapshot of the active job state, not persisted.
+type JobStatus struct {
+	JobID ID `json:"jobId"`
+	// Spec is a copy of the job spec with secrets of data sources redacted.
+	Spec      *JobSpec `json:"spec"`
+	IsRunning bool     `json:"isRunning"`
+
+	ConfigDigest ID `json:"configDigest,omitempty"`
+
+	LastObservation   string     `json:"lastObservation,omitempty"`
+	LastObservationAt *time.Time `json:"lastObservationAt,omitempty"`
+
+	LastTransmissionTxHash string     `json:"lastTransmissionTxHash,omitempty"`
+	LastTransmissionAt     *time.Time `json:"lastTransmissionAt,omitempty"`
+
+	LastError   string     `json:"lastError,omitempty"`
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+
+	// MembershipError explains why the node doesn't participate in the feed, as verified at job start.
+	MembershipError string `json:"membershipError,omitempty"`
+
+	// Transmissions are the recent transmission Txs with their final results, newest first.
+	Transmissions []*TransmissionRecord `json:"transmissions,omitempty"`
+}
+
+// TransmissionRecord is the final result of a transmission Tx, not persisted.
+type TransmissionRecord struct {
+	TxHash       string `json:"txHash"`
+	ConfigDigest ID     `json:"configDigest"`
+	Epoch        uint32 `json:"epoch"`
+	Round        uint8  `json:"round"`
+
+	// Status is one of: included, failed, unconfirmed.
+	Status string `json:"status"`
+	Height int64  `json:"height,omitempty"`
+	Code   uint32 `json:"code,omitempty"`
+	Log    string `json:"log,omitempty"`
+
+	// TxGasWanted, TxGasUsed and TxFees are totals of the whole Tx,
+	// shared by TxNumMsgs transmissions if the Tx was batched.
+	TxGasWanted int64  `json:"txGasWanted,omitempty"`
+	TxGasUsed   int64  `json:"txGasUsed,omitempty"`
+	TxFees      string `json:"txFees,omitempty"`
+	TxNumMsgs   int    `json:"txNumMsgs,omitempty"`
+
+	SentAt      time.Time  `json:"sentAt"`
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty"`
 }
 
 type JobPersistentState struct {
@@ -98,6 +204,29 @@ type JobPeerAnnouncement struct {
 	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
 }
 
+// SchemaMigration is a record of an applied DB schema migration.
+type SchemaMigration struct {
+	Version     int        `json:"version" bson:"version"`
+	Description string     `json:"description" bson:"description"`
+	AppliedAt   *time.Time `json:"appliedAt,omitempty" bson:"appliedAt,omitempty"`
+}
+
+// Archive is a portable backup of the oracle state, it can be restored into any DB engine.
+type Archive struct {
+	Version   int           `json:"version"`
+	CreatedAt time.Time     `json:"createdAt"`
+	Jobs      []*ArchiveJob `json:"jobs"`
+}
+
+// ArchiveJob is a job together with its OCR state and peer announcements.
+type ArchiveJob struct {
+	Job                  *Job                      `json:"job"`
+	PersistentStates     []*JobPersistentState     `json:"persistentStates"`
+	ContractConfig       *JobContractConfig        `json:"contractConfig,omitempty"`
+	PendingTransmissions []*JobPendingTransmission `json:"pendingTransmissions"`
+	PeerAnnouncements    []*JobPeerAnnouncement    `json:"peerAnnouncements"`
+}
+
 type Cursor struct {
 	From  primitive.ObjectID  `json:"from,omitempty"`
 	To    *primitive.ObjectID `json:"to,omitempty"`
@@ -115,6 +244,21 @@ func (h HexBytes) MarshalJSON() ([]byte, error) {
 	return buf, nil
 }
 
+func (h *HexBytes) UnmarshalJSON(input []byte) error {
+	var v string
+	if err := json.Unmarshal(input, &v); err != nil {
+		return errors.Wrap(err, "HexBytes must be a JSON string")
+	}
+
+	data, err := hex.DecodeString(v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode hex string: %s", v)
+	}
+
+	*h = HexBytes(data)
+	return nil
+}
+
 func (h HexBytes) MarshalBSONValue() (bsontype.Type, []byte, error) {
 	buf := bsoncore.AppendString(nil, hex.EncodeToString([]byte(h)))
 	return bsontype.String, buf, nil
@@ -0,0 +1,13 @@
+package db
+
+// Storage is the DB engine of the oracle. Every engine implements it fully,
+// so the rest of the app never needs to know which one is configured.
+type Storage interface {
+	JobCollection
+
+	// JobDBService returns the storage of OCR state and peer announcements for the job.
+	JobDBService(jobID string) (JobDBService, error)
+
+	String() string
+	Close()
+}
@@ -0,0 +1,613 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/dbconn"
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+// storageFactory opens an empty storage and returns a func that closes and cleans it up.
+type storageFactory func() (Storage, func())
+
+// MongoDB and PostgreSQL engines are tested only when a test instance is provided.
+const (
+	testMongoConnectionEnv = "ORACLE_TEST_MONGO_CONNECTION"
+	testPostgresURLEnv     = "ORACLE_TEST_PG_CONNECTION"
+)
+
+var _ = Describe("In-memory storage", func() {
+	storageConformance(func() (Storage, func()) {
+		storage := NewMemoryDBService()
+		return storage, storage.Close
+	})
+})
+
+var _ = Describe("Bolt storage", func() {
+	storageConformance(func() (Storage, func()) {
+		dir, err := ioutil.TempDir("", "ocr2-bolt")
+		Expect(err).To(BeNil())
+
+		storage, err := NewBoltDBService(filepath.Join(dir, "ocr2.db"))
+		Expect(err).To(BeNil())
+
+		return storage, func() {
+			storage.Close()
+			_ = os.RemoveAll(dir)
+		}
+	})
+})
+
+var _ = Describe("MongoDB storage", func() {
+	connection := os.Getenv(testMongoConnectionEnv)
+	if len(connection) == 0 {
+		It("is skipped", func() {
+			Skip(testMongoConnectionEnv + " is not set")
+		})
+
+		return
+	}
+
+	storageConformance(func() (Storage, func()) {
+		ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancelFn()
+
+		conn, err := dbconn.NewMongoConn(ctx, &dbconn.MongoConfig{
+			Connection: connection,
+			Database:   fmt.Sprintf("ocr2_test_%d", time.Now().UnixNano()),
+		})
+		Expect(err).To(BeNil())
+
+		_, err = MigrateMongo(ctx, conn)
+		Expect(err).To(BeNil())
+
+		storage, err := NewDBService(conn)
+		Expect(err).To(BeNil())
+
+		return storage, func() {
+			_ = storage.Client().Database(storage.DBName()).Drop(context.Background())
+			_ = conn.Close()
+		}
+	})
+})
+
+var _ = Describe("PostgreSQL storage", func() {
+	pgURL := os.Getenv(testPostgresURLEnv)
+	if len(pgURL) == 0 {
+		It("is skipped", func() {
+			Skip(testPostgresURLEnv + " is not set")
+		})
+
+		return
+	}
+
+	storageConformance(func() (Storage, func()) {
+		u, err := url.ParseRequestURI(pgURL)
+		Expect(err).To(BeNil())
+
+		storage, err := NewExternalPostgres(u)
+		Expect(err).To(BeNil())
+
+		return storage, func() {
+			_ = storage.Client().Migrator().DropTable(
+				&gormJob{},
+				&gormPersistentState{},
+				&gormContractConfig{},
+				&gormPendingTransmission{},
+				&gormPeerAnnouncement{},
+			)
+			storage.Close()
+		}
+	})
+})
+
+// storageConformance describes behaviour every storage engine must have.
+func storageConformance(newStorage storageFactory) {
+	var (
+		ctx     context.Context
+		storage Storage
+		cleanup func()
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		storage, cleanup = newStorage()
+
+		// engines keep at least millisecond precision
+		now = time.Now().UTC().Truncate(time.Millisecond)
+	})
+
+	AfterEach(func() {
+		cleanup()
+	})
+
+	newJob := func(jobID string, createdAt time.Time) *model.Job {
+		return &model.Job{
+			JobID: model.ID(jobID),
+			Spec: &model.JobSpec{
+				FeedID:             "LINK/USDC",
+				KeyID:              "key",
+				P2PBootstrapPeers:  []string{"peer@127.0.0.1:9999"},
+				BlockchainTimeout:  "20s",
+				ObservationTimeout: "10s",
+				DataSource: &model.DataSourceSpec{
+					Type:  model.DataSourceStatic,
+					Value: "42",
+				},
+			},
+			IsActive:  true,
+			CreatedAt: createdAt,
+		}
+	}
+
+	jobIDs := func(jobs []*model.Job) []model.ID {
+		ids := make([]model.ID, 0, len(jobs))
+		for _, job := range jobs {
+			ids = append(ids, job.JobID)
+		}
+
+		return ids
+	}
+
+	Describe("jobs", func() {
+		It("lists active jobs in order of creation", func() {
+			Expect(storage.UpsertJob(ctx, newJob("job2", now.Add(time.Second)))).To(BeNil())
+			Expect(storage.UpsertJob(ctx, newJob("job1", now))).To(BeNil())
+
+			inactive := newJob("job3", now)
+			inactive.IsActive = false
+			Expect(storage.UpsertJob(ctx, inactive)).To(BeNil())
+
+			jobs, err := storage.ListJobs(ctx, &model.Cursor{Limit: 100})
+			Expect(err).To(BeNil())
+			Expect(jobIDs(jobs)).To(Equal([]model.ID{"job1", "job2"}))
+
+			jobs, err = storage.ListJobs(ctx, &model.Cursor{Limit: 1})
+			Expect(err).To(BeNil())
+			Expect(jobIDs(jobs)).To(Equal([]model.ID{"job1"}))
+
+			jobs, err = storage.ListAllJobs(ctx)
+			Expect(err).To(BeNil())
+			Expect(jobIDs(jobs)).To(ConsistOf(model.ID("job1"), model.ID("job2"), model.ID("job3")))
+		})
+
+		It("keeps the full job spec", func() {
+			job := newJob("job1", now)
+			Expect(storage.UpsertJob(ctx, job)).To(BeNil())
+
+			jobs, err := storage.ListJobs(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(jobs).To(HaveLen(1))
+			Expect(jobs[0].Spec).To(Equal(job.Spec))
+			Expect(jobs[0].CreatedAt).To(BeTemporally("==", now))
+		})
+
+		It("updates existing jobs", func() {
+			job := newJob("job1", now)
+			Expect(storage.UpsertJob(ctx, job)).To(BeNil())
+
+			job.Spec.FeedID = "INJ/USDT"
+			Expect(storage.UpsertJob(ctx, job)).To(BeNil())
+
+			jobs, err := storage.ListJobs(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(jobs).To(HaveLen(1))
+			Expect(jobs[0].Spec.FeedID).To(Equal(model.ID("INJ/USDT")))
+
+			job.IsActive = false
+			Expect(storage.UpsertJob(ctx, job)).To(BeNil())
+
+			jobs, err = storage.ListJobs(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(jobs).To(BeEmpty())
+		})
+
+		It("deletes jobs", func() {
+			Expect(storage.UpsertJob(ctx, newJob("job1", now))).To(BeNil())
+			Expect(storage.UpsertJob(ctx, newJob("job2", now))).To(BeNil())
+			Expect(storage.DeleteJob(ctx, "job1")).To(BeNil())
+
+			jobs, err := storage.ListJobs(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(jobIDs(jobs)).To(Equal([]model.ID{"job2"}))
+
+			Expect(storage.DeleteJob(ctx, "missing")).To(BeNil())
+		})
+
+		It("rejects jobs without ID", func() {
+			Expect(storage.UpsertJob(ctx, newJob("", now))).ToNot(BeNil())
+		})
+	})
+
+	Describe("job state", func() {
+		var jobDB JobDBService
+
+		BeforeEach(func() {
+			var err error
+			jobDB, err = storage.JobDBService("job1")
+			Expect(err).To(BeNil())
+			Expect(jobDB.JobID()).To(Equal(model.ID("job1")))
+		})
+
+		It("stores persistent states by config digest", func() {
+			_, err := jobDB.GetPersistentState(ctx, "digest1")
+			Expect(err).To(Equal(ErrNotFound))
+
+			state := &model.JobPersistentState{
+				ConfigDigest:         "digest1",
+				Epoch:                3,
+				HighestSentEpoch:     2,
+				HighestReceivedEpoch: []uint32{1, 2, 3, 4},
+			}
+			Expect(jobDB.SetPersistentState(ctx, state)).To(BeNil())
+
+			state.Epoch = 4
+			Expect(jobDB.SetPersistentState(ctx, state)).To(BeNil())
+
+			result, err := jobDB.GetPersistentState(ctx, "digest1")
+			Expect(err).To(BeNil())
+			Expect(result.JobID).To(Equal(model.ID("job1")))
+			Expect(result.Epoch).To(Equal(uint32(4)))
+			Expect(result.HighestSentEpoch).To(Equal(uint32(2)))
+			Expect(result.HighestReceivedEpoch).To(Equal([]uint32{1, 2, 3, 4}))
+
+			_, err = jobDB.GetPersistentState(ctx, "digest2")
+			Expect(err).To(Equal(ErrNotFound))
+
+			Expect(jobDB.SetPersistentState(ctx, &model.JobPersistentState{
+				ConfigDigest: "digest2",
+				Epoch:        1,
+			})).To(BeNil())
+
+			result, err = jobDB.GetPersistentState(ctx, "digest2")
+			Expect(err).To(BeNil())
+			Expect(result.Epoch).To(Equal(uint32(1)))
+
+			result, err = jobDB.GetPersistentState(ctx, "digest1")
+			Expect(err).To(BeNil())
+			Expect(result.Epoch).To(Equal(uint32(4)))
+		})
+
+		It("stores the contract config", func() {
+			_, err := jobDB.GetContractConfig(ctx)
+			Expect(err).To(Equal(ErrNotFound))
+
+			config := &model.JobContractConfig{
+				ConfigDigest:          "digest1",
+				ConfigCount:           1,
+				Signers:               []model.HexBytes{{0x01, 0x02}},
+				Transmitters:          []model.Account{"inj1transmitter"},
+				F:                     1,
+				OnchainConfig:         model.HexBytes{0x03},
+				OffchainConfigVersion: 2,
+				OffchainConfig:        []byte{0x04, 0x05},
+			}
+			Expect(jobDB.SetContractConfig(ctx, config)).To(BeNil())
+
+			config.ConfigDigest = "digest2"
+			config.ConfigCount = 2
+			Expect(jobDB.SetContractConfig(ctx, config)).To(BeNil())
+
+			result, err := jobDB.GetContractConfig(ctx)
+			Expect(err).To(BeNil())
+			Expect(result.JobID).To(Equal(model.ID("job1")))
+			Expect(result.ConfigDigest).To(Equal(model.ID("digest2")))
+			Expect(result.ConfigCount).To(Equal(uint64(2)))
+			Expect(result.Signers).To(Equal(config.Signers))
+			Expect(result.Transmitters).To(Equal(config.Transmitters))
+			Expect(result.F).To(Equal(uint8(1)))
+			Expect(result.OnchainConfig).To(Equal(config.OnchainConfig))
+			Expect(result.OffchainConfigVersion).To(Equal(uint64(2)))
+			Expect(result.OffchainConfig).To(Equal(config.OffchainConfig))
+		})
+
+		Describe("pending transmissions", func() {
+			newPendingTx := func(configDigest model.ID, epoch uint32, round uint8, txTime time.Time) *model.JobPendingTransmission {
+				return &model.JobPendingTransmission{
+					ConfigDigest: configDigest,
+					ReportTimestamp: model.ReportTimestamp{
+						Epoch: epoch,
+						Round: round,
+					},
+					Transmission: model.PendingTransmission{
+						Time:      txTime,
+						ExtraHash: model.HexBytes{0x01},
+						Report:    model.HexBytes{0x02},
+						AttributedSignatures: []model.AttributedOnchainSignature{{
+							Signature: model.HexBytes{0x03},
+							Signer:    1,
+						}},
+					},
+					CreatedAt: txTime,
+				}
+			}
+
+			timestamps := func(pendingTransmissions []*model.JobPendingTransmission) []model.ReportTimestamp {
+				result := make([]model.ReportTimestamp, 0, len(pendingTransmissions))
+				for _, pendingTx := range pendingTransmissions {
+					result = append(result, pendingTx.ReportTimestamp)
+				}
+
+				return result
+			}
+
+			It("lists pending transmissions of the config digest in order of creation", func() {
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest1", 2, 1, now.Add(time.Second)))).To(BeNil())
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest1", 1, 1, now))).To(BeNil())
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest2", 3, 1, now))).To(BeNil())
+
+				result, err := jobDB.ListPendingTransmissions(ctx, "digest1", &model.Cursor{Limit: 100})
+				Expect(err).To(BeNil())
+				Expect(timestamps(result)).To(Equal([]model.ReportTimestamp{{Epoch: 1, Round: 1}, {Epoch: 2, Round: 1}}))
+
+				Expect(result[0].JobID).To(Equal(model.ID("job1")))
+				Expect(result[0].Transmission.Time).To(BeTemporally("==", now))
+				Expect(result[0].Transmission.ExtraHash).To(Equal(model.HexBytes{0x01}))
+				Expect(result[0].Transmission.Report).To(Equal(model.HexBytes{0x02}))
+				Expect(result[0].Transmission.AttributedSignatures).To(Equal([]model.AttributedOnchainSignature{{
+					Signature: model.HexBytes{0x03},
+					Signer:    1,
+				}}))
+
+				result, err = jobDB.ListPendingTransmissions(ctx, "digest3", nil)
+				Expect(err).To(BeNil())
+				Expect(result).To(BeEmpty())
+			})
+
+			It("replaces the pending transmission of the same report timestamp", func() {
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest1", 1, 1, now))).To(BeNil())
+
+				pendingTx := newPendingTx("digest1", 1, 1, now)
+				pendingTx.Transmission.Report = model.HexBytes{0x05}
+				Expect(jobDB.InsertPendingTranmission(ctx, pendingTx)).To(BeNil())
+
+				result, err := jobDB.ListPendingTransmissions(ctx, "digest1", nil)
+				Expect(err).To(BeNil())
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].Transmission.Report).To(Equal(model.HexBytes{0x05}))
+			})
+
+			It("deletes pending transmissions", func() {
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest1", 1, 1, now.Add(-time.Hour)))).To(BeNil())
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest1", 1, 2, now.Add(-time.Minute)))).To(BeNil())
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest1", 2, 1, now))).To(BeNil())
+
+				Expect(jobDB.DeletePendingTransmission(ctx, model.ReportTimestamp{Epoch: 2, Round: 1})).To(BeNil())
+
+				result, err := jobDB.ListPendingTransmissions(ctx, "digest1", nil)
+				Expect(err).To(BeNil())
+				Expect(timestamps(result)).To(Equal([]model.ReportTimestamp{{Epoch: 1, Round: 1}, {Epoch: 1, Round: 2}}))
+
+				Expect(jobDB.DeletePendingTransmissionsOlderThan(ctx, now.Add(-30*time.Minute))).To(BeNil())
+
+				result, err = jobDB.ListPendingTransmissions(ctx, "digest1", nil)
+				Expect(err).To(BeNil())
+				Expect(timestamps(result)).To(Equal([]model.ReportTimestamp{{Epoch: 1, Round: 2}}))
+			})
+
+			It("deletes pending transmissions of the report timestamp under all config digests", func() {
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest1", 3, 1, now))).To(BeNil())
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest2", 3, 1, now))).To(BeNil())
+				Expect(jobDB.InsertPendingTranmission(ctx, newPendingTx("digest2", 3, 2, now))).To(BeNil())
+
+				Expect(jobDB.DeletePendingTransmission(ctx, model.ReportTimestamp{Epoch: 3, Round: 1})).To(BeNil())
+
+				result, err := jobDB.ListPendingTransmissions(ctx, "digest1", nil)
+				Expect(err).To(BeNil())
+				Expect(result).To(BeEmpty())
+
+				result, err = jobDB.ListPendingTransmissions(ctx, "digest2", nil)
+				Expect(err).To(BeNil())
+				Expect(timestamps(result)).To(Equal([]model.ReportTimestamp{{Epoch: 3, Round: 2}}))
+			})
+		})
+
+		It("stores peer announcements", func() {
+			Expect(jobDB.UpsertAnnouncement(ctx, &model.JobPeerAnnouncement{
+				PeerID:    "peer1",
+				Announce:  []byte{0x01},
+				CreatedAt: now,
+			})).To(BeNil())
+			Expect(jobDB.UpsertAnnouncement(ctx, &model.JobPeerAnnouncement{
+				PeerID:    "peer2",
+				Announce:  []byte{0x02},
+				CreatedAt: now.Add(time.Second),
+			})).To(BeNil())
+			Expect(jobDB.UpsertAnnouncement(ctx, &model.JobPeerAnnouncement{
+				PeerID:    "peer1",
+				Announce:  []byte{0x03},
+				CreatedAt: now.Add(2 * time.Second),
+			})).To(BeNil())
+
+			result, err := jobDB.ListAnnouncements(ctx, []string{"peer1", "peer2", "peer3"}, nil)
+			Expect(err).To(BeNil())
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].PeerID).To(Equal(model.ID("peer2")))
+			Expect(result[0].Announce).To(Equal([]byte{0x02}))
+			Expect(result[1].PeerID).To(Equal(model.ID("peer1")))
+			Expect(result[1].Announce).To(Equal([]byte{0x03}))
+			Expect(result[1].JobID).To(Equal(model.ID("job1")))
+
+			result, err = jobDB.ListAnnouncements(ctx, []string{"peer2"}, nil)
+			Expect(err).To(BeNil())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].PeerID).To(Equal(model.ID("peer2")))
+		})
+
+		It("isolates state of jobs", func() {
+			otherJobDB, err := storage.JobDBService("job2")
+			Expect(err).To(BeNil())
+
+			Expect(jobDB.SetPersistentState(ctx, &model.JobPersistentState{
+				ConfigDigest: "digest1",
+				Epoch:        1,
+			})).To(BeNil())
+			Expect(jobDB.SetContractConfig(ctx, &model.JobContractConfig{
+				ConfigDigest: "digest1",
+			})).To(BeNil())
+			Expect(jobDB.InsertPendingTranmission(ctx, &model.JobPendingTransmission{
+				ConfigDigest:    "digest1",
+				ReportTimestamp: model.ReportTimestamp{Epoch: 1, Round: 1},
+				Transmission:    model.PendingTransmission{Time: now},
+				CreatedAt:       now,
+			})).To(BeNil())
+			Expect(jobDB.UpsertAnnouncement(ctx, &model.JobPeerAnnouncement{
+				PeerID:    "peer1",
+				Announce:  []byte{0x01},
+				CreatedAt: now,
+			})).To(BeNil())
+
+			_, err = otherJobDB.GetPersistentState(ctx, "digest1")
+			Expect(err).To(Equal(ErrNotFound))
+
+			_, err = otherJobDB.GetContractConfig(ctx)
+			Expect(err).To(Equal(ErrNotFound))
+
+			pendingTransmissions, err := otherJobDB.ListPendingTransmissions(ctx, "digest1", nil)
+			Expect(err).To(BeNil())
+			Expect(pendingTransmissions).To(BeEmpty())
+
+			announcements, err := otherJobDB.ListAnnouncements(ctx, []string{"peer1"}, nil)
+			Expect(err).To(BeNil())
+			Expect(announcements).To(BeEmpty())
+
+			// the same config digest and report timestamp in another job don't collide
+			Expect(otherJobDB.InsertPendingTranmission(ctx, &model.JobPendingTransmission{
+				ConfigDigest:    "digest1",
+				ReportTimestamp: model.ReportTimestamp{Epoch: 1, Round: 1},
+				Transmission:    model.PendingTransmission{Time: now},
+				CreatedAt:       now,
+			})).To(BeNil())
+			Expect(otherJobDB.SetPersistentState(ctx, &model.JobPersistentState{
+				ConfigDigest: "digest1",
+				Epoch:        2,
+			})).To(BeNil())
+
+			state, err := jobDB.GetPersistentState(ctx, "digest1")
+			Expect(err).To(BeNil())
+			Expect(state.Epoch).To(Equal(uint32(1)))
+
+			Expect(otherJobDB.DeletePendingTransmissionsOlderThan(ctx, now.Add(time.Hour))).To(BeNil())
+
+			pendingTransmissions, err = jobDB.ListPendingTransmissions(ctx, "digest1", nil)
+			Expect(err).To(BeNil())
+			Expect(pendingTransmissions).To(HaveLen(1))
+		})
+	})
+
+	Describe("archive", func() {
+		It("exports and imports jobs with their state", func() {
+			Expect(storage.UpsertJob(ctx, newJob("job1", now))).To(BeNil())
+			Expect(storage.UpsertJob(ctx, newJob("job2", now.Add(time.Second)))).To(BeNil())
+
+			inactive := newJob("job3", now.Add(2*time.Second))
+			inactive.IsActive = false
+			Expect(storage.UpsertJob(ctx, inactive)).To(BeNil())
+
+			jobDB, err := storage.JobDBService("job1")
+			Expect(err).To(BeNil())
+
+			for _, configDigest := range []model.ID{"digest2", "digest1"} {
+				Expect(jobDB.SetPersistentState(ctx, &model.JobPersistentState{
+					ConfigDigest:         configDigest,
+					Epoch:                3,
+					HighestReceivedEpoch: []uint32{1, 2},
+				})).To(BeNil())
+			}
+			Expect(jobDB.SetContractConfig(ctx, &model.JobContractConfig{
+				ConfigDigest: "digest1",
+				ConfigCount:  1,
+				Signers:      []model.HexBytes{{0x01}},
+			})).To(BeNil())
+			for i, configDigest := range []model.ID{"digest1", "digest2"} {
+				Expect(jobDB.InsertPendingTranmission(ctx, &model.JobPendingTransmission{
+					ConfigDigest:    configDigest,
+					ReportTimestamp: model.ReportTimestamp{Epoch: 1, Round: 1},
+					Transmission: model.PendingTransmission{
+						Time:   now,
+						Report: model.HexBytes{0x02},
+					},
+					CreatedAt: now.Add(time.Duration(i) * time.Second),
+				})).To(BeNil())
+			}
+			Expect(jobDB.UpsertAnnouncement(ctx, &model.JobPeerAnnouncement{
+				PeerID:    "peer1",
+				Announce:  []byte{0x03},
+				CreatedAt: now,
+			})).To(BeNil())
+
+			archive, err := ExportArchive(ctx, storage)
+			Expect(err).To(BeNil())
+			Expect(archive.Version).To(Equal(ArchiveVersion))
+			Expect(archive.Jobs).To(HaveLen(3))
+
+			job1 := archive.Jobs[0]
+			Expect(job1.Job.JobID).To(Equal(model.ID("job1")))
+			Expect(job1.PersistentStates).To(HaveLen(2))
+			Expect(job1.PersistentStates[0].ConfigDigest).To(Equal(model.ID("digest1")))
+			Expect(job1.ContractConfig).ToNot(BeNil())
+			Expect(job1.PendingTransmissions).To(HaveLen(2))
+			Expect(job1.PendingTransmissions[0].ConfigDigest).To(Equal(model.ID("digest1")))
+			Expect(job1.PeerAnnouncements).To(HaveLen(1))
+
+			job2 := archive.Jobs[1]
+			Expect(job2.Job.JobID).To(Equal(model.ID("job2")))
+			Expect(job2.PersistentStates).To(BeEmpty())
+			Expect(job2.ContractConfig).To(BeNil())
+			Expect(job2.PendingTransmissions).To(BeEmpty())
+			Expect(job2.PeerAnnouncements).To(BeEmpty())
+
+			job3 := archive.Jobs[2]
+			Expect(job3.Job.JobID).To(Equal(model.ID("job3")))
+			Expect(job3.Job.IsActive).To(BeFalse())
+
+			restored := NewMemoryDBService()
+			Expect(ImportArchive(ctx, restored, archive)).To(BeNil())
+
+			restoredJobDB, err := restored.JobDBService("job1")
+			Expect(err).To(BeNil())
+
+			state, err := restoredJobDB.GetPersistentState(ctx, "digest2")
+			Expect(err).To(BeNil())
+			Expect(state.HighestReceivedEpoch).To(Equal([]uint32{1, 2}))
+
+			config, err := restoredJobDB.GetContractConfig(ctx)
+			Expect(err).To(BeNil())
+			Expect(config.Signers).To(Equal([]model.HexBytes{{0x01}}))
+
+			pendingTransmissions, err := restoredJobDB.ListPendingTransmissions(ctx, "digest2", nil)
+			Expect(err).To(BeNil())
+			Expect(pendingTransmissions).To(HaveLen(1))
+			Expect(pendingTransmissions[0].Transmission.Report).To(Equal(model.HexBytes{0x02}))
+
+			announcements, err := restoredJobDB.ListAnnouncements(ctx, []string{"peer1"}, nil)
+			Expect(err).To(BeNil())
+			Expect(announcements).To(HaveLen(1))
+
+			activeJobs, err := restored.ListJobs(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(jobIDs(activeJobs)).To(Equal([]model.ID{"job1", "job2"}))
+
+			restoredArchive, err := ExportArchive(ctx, restored)
+			Expect(err).To(BeNil())
+			Expect(restoredArchive.Jobs).To(HaveLen(3))
+			Expect(jobIDs([]*model.Job{restoredArchive.Jobs[0].Job, restoredArchive.Jobs[1].Job, restoredArchive.Jobs[2].Job})).
+				To(Equal([]model.ID{"job1", "job2", "job3"}))
+		})
+
+		It("rejects archives of unknown version", func() {
+			err := ImportArchive(ctx, storage, &model.Archive{Version: ArchiveVersion + 1})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+}
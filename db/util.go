@@ -28,3 +28,24 @@ func newFindOptionsWithCursor(cursor *model.Cursor) *options.FindOptions {
 
 	return opts
 }
+
+// cursorLimit returns the max number of records to list, zero means no limit.
+func cursorLimit(cursor *model.Cursor) int {
+	if cursor == nil {
+		return defaultListLimit
+	} else if cursor.Limit > 0 {
+		return cursor.Limit
+	}
+
+	return 0
+}
+
+// cursorLen returns the number of records to return out of n, for engines
+// that support only the Limit of cursor.
+func cursorLen(cursor *model.Cursor, n int) int {
+	if limit := cursorLimit(cursor); limit > 0 && limit < n {
+		return limit
+	}
+
+	return n
+}
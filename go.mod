@@ -17,6 +17,7 @@ require (
 	github.com/onsi/ginkgo v1.16.5
 	github.com/onsi/gomega v1.16.0
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.11.0
 	github.com/shopspring/decimal v1.3.1
 	github.com/smartcontractkit/chainlink v0.10.10-0.20211101125004-5d2ce656d2b6
 	github.com/smartcontractkit/chainlink-relay v0.0.0-20211115221612-75f3bf775211
@@ -24,12 +25,14 @@ require (
 	github.com/tendermint/tendermint v0.34.13
 	github.com/xlab/closer v0.0.0-20190328110542-03326addb7c2
 	github.com/xlab/suplog v1.3.1
+	go.etcd.io/bbolt v1.3.5
 	go.mongodb.org/mongo-driver v1.7.2
 	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
 	google.golang.org/genproto v0.0.0-20210828152312-66f60bf46e71
 	google.golang.org/grpc v1.42.0
 	google.golang.org/protobuf v1.27.1
 	gopkg.in/alexcesaro/statsd.v2 v2.0.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0
 	gorm.io/driver/postgres v1.2.2
 	gorm.io/gorm v1.22.2
 )
@@ -0,0 +1,193 @@
+package injective
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+// BalanceMonitor periodically checks fee balances of transmitter accounts,
+// along with the LINK owed to them by the OCR module.
+type BalanceMonitor struct {
+	Addresses       []sdk.AccAddress
+	BankQueryClient banktypes.QueryClient
+	QueryClient     chaintypes.QueryClient
+
+	// MinBalance sets the fee denom and the balance threshold, accounts below it
+	// are reported as low on funds. Zero amount disables the check.
+	MinBalance sdk.Coin
+	// Interval sets how often the balances are checked.
+	Interval time.Duration
+
+	initOnce  sync.Once
+	onceStart sync.Once
+	onceStop  sync.Once
+	closeC    chan struct{}
+
+	balancesMux *sync.RWMutex
+	balances    map[string]sdk.Coin
+
+	logger log.Logger
+}
+
+const (
+	defaultBalanceCheckInterval = time.Minute
+	balanceQueryTimeout         = 10 * time.Second
+)
+
+func (m *BalanceMonitor) init() {
+	m.initOnce.Do(func() {
+		if m.Interval == 0 {
+			m.Interval = defaultBalanceCheckInterval
+		}
+
+		m.closeC = make(chan struct{})
+		m.balancesMux = new(sync.RWMutex)
+		m.balances = make(map[string]sdk.Coin, len(m.Addresses))
+		m.logger = log.WithFields(log.Fields{
+			"svc": "balance_monitor",
+		})
+	})
+}
+
+// Start checks the balances once, then keeps checking them in background.
+func (m *BalanceMonitor) Start() error {
+	m.init()
+
+	if m.BankQueryClient == nil {
+		err := errors.New("cannot monitor balances: no BankQueryClient set")
+		return err
+	}
+
+	m.onceStart.Do(func() {
+		m.checkBalances()
+
+		go m.run()
+	})
+
+	return nil
+}
+
+func (m *BalanceMonitor) Close() error {
+	m.init()
+
+	m.onceStop.Do(func() {
+		close(m.closeC)
+	})
+
+	return nil
+}
+
+// CheckHealth reports an error if any of the accounts is low on funds, according to the last
+// successful check. Failed queries are only logged and reported as metrics, so a transient
+// gRPC outage doesn't fail readiness, it's probed by the gRPC health check anyway.
+func (m *BalanceMonitor) CheckHealth(ctx context.Context) error {
+	m.init()
+
+	m.balancesMux.RLock()
+	defer m.balancesMux.RUnlock()
+
+	for _, addr := range m.Addresses {
+		balance, ok := m.balances[addr.String()]
+		if !ok {
+			continue
+		}
+
+		if m.isLow(balance) {
+			return errors.Errorf("transmitter %s is low on funds: %s, expected at least %s", addr.String(), balance, m.MinBalance)
+		}
+	}
+
+	return nil
+}
+
+func (m *BalanceMonitor) run() {
+	t := time.NewTicker(m.Interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-m.closeC:
+			return
+		case <-t.C:
+			m.checkBalances()
+		}
+	}
+}
+
+func (m *BalanceMonitor) checkBalances() {
+	for _, addr := range m.Addresses {
+		if err := m.checkAccount(addr); err != nil {
+			m.logger.WithError(err).WithField("transmitter", addr.String()).Warningln("failed to check balance")
+		}
+	}
+}
+
+func (m *BalanceMonitor) checkAccount(addr sdk.AccAddress) error {
+	ctx, cancelFn := context.WithTimeout(context.Background(), balanceQueryTimeout)
+	defer cancelFn()
+
+	metricTags := metrics.Tags{
+		"transmitter": addr.String(),
+	}
+
+	resp, err := m.BankQueryClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: addr.String(),
+		Denom:   m.MinBalance.Denom,
+	})
+	if err != nil {
+		metrics.ReportTransmitterCheckFailed("balance", metricTags)
+
+		err = errors.Wrap(err, "failed to query bank balance")
+		return err
+	}
+
+	balance := sdk.NewCoin(m.MinBalance.Denom, sdk.ZeroInt())
+	if resp.Balance != nil {
+		balance = *resp.Balance
+	}
+
+	m.balancesMux.Lock()
+	m.balances[addr.String()] = balance
+	m.balancesMux.Unlock()
+
+	metrics.ReportTransmitterBalance(balance.Denom, balance.Amount.BigInt(), metricTags)
+
+	if m.isLow(balance) {
+		m.logger.WithFields(log.Fields{
+			"transmitter": addr.String(),
+			"balance":     balance.String(),
+			"minBalance":  m.MinBalance.String(),
+		}).Warningln("⚠️  transmitter is low on funds, transmissions will fail")
+	}
+
+	if m.QueryClient == nil {
+		return nil
+	}
+
+	owedResp, err := m.QueryClient.OwedAmount(ctx, &chaintypes.QueryOwedAmountRequest{
+		Transmitter: addr.String(),
+	})
+	if err != nil {
+		metrics.ReportTransmitterCheckFailed("owed", metricTags)
+
+		err = errors.Wrap(err, "failed to query owed amount")
+		return err
+	}
+
+	metrics.ReportTransmitterOwed(owedResp.Amount.Denom, owedResp.Amount.Amount.BigInt(), metricTags)
+
+	return nil
+}
+
+func (m *BalanceMonitor) isLow(balance sdk.Coin) bool {
+	return m.MinBalance.IsPositive() && balance.Amount.LT(m.MinBalance.Amount)
+}
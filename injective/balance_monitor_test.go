@@ -0,0 +1,132 @@
+package injective
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+var _ = Describe("BalanceMonitor", func() {
+	var (
+		bank    *fakeBankQueryClient
+		ocr     *fakeOwedQueryClient
+		monitor *BalanceMonitor
+	)
+
+	BeforeEach(func() {
+		bank = &fakeBankQueryClient{
+			balance: sdk.NewInt64Coin("inj", 1000),
+		}
+		ocr = &fakeOwedQueryClient{}
+		monitor = &BalanceMonitor{
+			Addresses:       []sdk.AccAddress{testTransmitterAddress},
+			BankQueryClient: bank,
+			QueryClient:     ocr,
+			MinBalance:      sdk.NewInt64Coin("inj", 500),
+		}
+	})
+
+	AfterEach(func() {
+		Expect(monitor.Close()).To(BeNil())
+	})
+
+	It("fails the health check when a transmitter is low on funds", func() {
+		bank.balance = sdk.NewInt64Coin("inj", 100)
+
+		Expect(monitor.Start()).To(BeNil())
+		Expect(monitor.CheckHealth(context.Background())).ToNot(BeNil())
+	})
+
+	It("doesn't fail the health check when the threshold is not set", func() {
+		bank.balance = sdk.NewInt64Coin("inj", 0)
+		monitor.MinBalance = sdk.NewInt64Coin("inj", 0)
+
+		Expect(monitor.Start()).To(BeNil())
+		Expect(monitor.CheckHealth(context.Background())).To(BeNil())
+	})
+
+	It("doesn't fail the health check on query errors", func() {
+		ocr.setErr(errors.New("unavailable"))
+
+		Expect(monitor.Start()).To(BeNil())
+		Expect(monitor.CheckHealth(context.Background())).To(BeNil())
+
+		bank.setErr(errors.New("unavailable"))
+		monitor.checkBalances()
+		Expect(monitor.CheckHealth(context.Background())).To(BeNil())
+	})
+
+	It("keeps the last checked balance when queries fail", func() {
+		bank.balance = sdk.NewInt64Coin("inj", 100)
+
+		Expect(monitor.Start()).To(BeNil())
+
+		bank.setErr(errors.New("unavailable"))
+		monitor.checkBalances()
+		Expect(monitor.CheckHealth(context.Background())).ToNot(BeNil())
+	})
+})
+
+type fakeBankQueryClient struct {
+	banktypes.QueryClient
+
+	mux     sync.Mutex
+	balance sdk.Coin
+	err     error
+}
+
+func (c *fakeBankQueryClient) setErr(err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.err = err
+}
+
+func (c *fakeBankQueryClient) Balance(ctx context.Context, in *banktypes.QueryBalanceRequest, opts ...grpc.CallOption) (*banktypes.QueryBalanceResponse, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	balance := c.balance
+	return &banktypes.QueryBalanceResponse{
+		Balance: &balance,
+	}, nil
+}
+
+type fakeOwedQueryClient struct {
+	chaintypes.QueryClient
+
+	mux sync.Mutex
+	err error
+}
+
+func (c *fakeOwedQueryClient) setErr(err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.err = err
+}
+
+func (c *fakeOwedQueryClient) OwedAmount(ctx context.Context, in *chaintypes.QueryOwedAmountRequest, opts ...grpc.CallOption) (*chaintypes.QueryOwedAmountResponse, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &chaintypes.QueryOwedAmountResponse{
+		Amount: sdk.NewInt64Coin("peggy0x514910771AF9Ca656af840dff83E8264EcF986CA", 0),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package injective
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// ChainAppVersion queries the application version of the chain node, e.g. v1.2.0
+func ChainAppVersion(ctx context.Context, conn *grpc.ClientConn) (string, error) {
+	nodeInfo, err := tmservice.NewServiceClient(conn).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		err = errors.Wrap(err, "failed to query node info")
+		return "", err
+	} else if nodeInfo.ApplicationVersion == nil {
+		err = errors.New("node info has no application version")
+		return "", err
+	}
+
+	return nodeInfo.ApplicationVersion.Version, nil
+}
+
+// ChainVersionAtLeast compares vX.Y.Z versions numerically, a pre-release
+// suffix (e.g. -rc1) or build metadata is ignored.
+func ChainVersionAtLeast(version, minVersion string) (bool, error) {
+	v, err := parseChainVersion(version)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to parse chain version %s", version)
+		return false, err
+	}
+
+	minV, err := parseChainVersion(minVersion)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to parse min chain version %s", minVersion)
+		return false, err
+	}
+
+	for i := range v {
+		if v[i] != minV[i] {
+			return v[i] > minV[i], nil
+		}
+	}
+
+	return true, nil
+}
+
+func parseChainVersion(version string) ([3]int, error) {
+	var parsed [3]int
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if idx := strings.IndexAny(version, "-+"); idx >= 0 {
+		version = version[:idx]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return parsed, errors.New("expected vX.Y.Z version")
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, errors.Errorf("invalid version component %q", part)
+		}
+
+		parsed[i] = n
+	}
+
+	return parsed, nil
+}
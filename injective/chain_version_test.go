@@ -0,0 +1,32 @@
+package injective
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChainVersionAtLeast", func() {
+	DescribeTable("compares chain versions",
+		func(version, minVersion string, expected bool) {
+			ok, err := ChainVersionAtLeast(version, minVersion)
+			Expect(err).To(BeNil())
+			Expect(ok).To(Equal(expected))
+		},
+		Entry("same version", "v1.2.0", "v1.2.0", true),
+		Entry("newer patch", "v1.2.1", "v1.2.0", true),
+		Entry("newer minor", "v1.10.0", "v1.9.3", true),
+		Entry("older major", "v0.9.9", "v1.0.0", false),
+		Entry("older minor", "v1.1.9", "v1.2.0", false),
+		Entry("pre-release suffix", "v1.2.0-rc1", "v1.2.0", true),
+		Entry("without v prefix", "1.3", "v1.2.5", true),
+	)
+
+	It("rejects malformed versions", func() {
+		_, err := ChainVersionAtLeast("dev", "v1.2.0")
+		Expect(err).ToNot(BeNil())
+
+		_, err = ChainVersionAtLeast("v1.2.0", "v1.2.0.1")
+		Expect(err).ToNot(BeNil())
+	})
+})
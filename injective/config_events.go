@@ -0,0 +1,289 @@
+package injective
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/chainlink-injective/injective/tmclient"
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+const (
+	configEventsSubscriber   = "ocr2_config_tracker"
+	configEventsStallTimeout = time.Minute
+	configEventsMinBackoff   = time.Second
+	configEventsMaxBackoff   = time.Minute
+	configQueryTimeout       = 10 * time.Second
+)
+
+var (
+	configSetEventType = proto.MessageName(&chaintypes.EventConfigSet{})
+
+	// EventConfigSet is emitted either in EndBlock (when a gov proposal passes),
+	// or by a Tx like MsgCreateFeed / MsgUpdateFeed. Block headers also serve as a heartbeat.
+	// The feed ID is nested in the event config, so queries can't be scoped by feed.
+	configSetBlockQuery = "tm.event='NewBlockHeader'"
+	configSetTxQuery    = fmt.Sprintf("tm.event='Tx' AND %s.config_digest EXISTS", configSetEventType)
+)
+
+// ConfigEventsHub shares a single Tendermint WS subscription to config events between
+// config trackers of all feeds, dispatching events by feed ID. Tendermint keys subscriptions
+// by query, so trackers can't subscribe on their own without colliding with each other.
+type ConfigEventsHub struct {
+	TendermintClient tmclient.TendermintClient
+
+	initOnce  sync.Once
+	onceStart sync.Once
+	onceStop  sync.Once
+	closeC    chan struct{}
+
+	listenersMux sync.RWMutex
+	listeners    map[*configEventsListener]struct{}
+	connected    bool
+
+	logger log.Logger
+}
+
+type configEventsListener struct {
+	feedID      string
+	onConfigSet func(configDigest []byte)
+	onResync    func()
+}
+
+func (h *ConfigEventsHub) init() {
+	h.initOnce.Do(func() {
+		h.closeC = make(chan struct{})
+		h.listeners = make(map[*configEventsListener]struct{})
+		h.logger = log.WithFields(log.Fields{
+			"svc": "config_events",
+		})
+	})
+}
+
+// Start begins listening to config events. When the subscription drops,
+// it reconnects with a backoff, the listeners are expected to poll meanwhile.
+func (h *ConfigEventsHub) Start() error {
+	h.init()
+
+	if h.TendermintClient == nil {
+		err := errors.New("cannot listen to config events: no TendermintClient set")
+		return err
+	}
+
+	h.onceStart.Do(func() {
+		go h.run()
+	})
+
+	return nil
+}
+
+// Close stops listening to config events.
+func (h *ConfigEventsHub) Close() error {
+	h.init()
+
+	h.onceStop.Do(func() {
+		close(h.closeC)
+	})
+
+	return nil
+}
+
+// Subscribe registers callbacks for the feed. onConfigSet gets the config digest of each
+// EventConfigSet of the feed, onResync is called upon (re)subscription, since events could
+// be missed meanwhile. Callbacks must not block. Returns a func that cancels the subscription.
+func (h *ConfigEventsHub) Subscribe(
+	feedID string,
+	onConfigSet func(configDigest []byte),
+	onResync func(),
+) (unsubscribe func()) {
+	h.init()
+
+	listener := &configEventsListener{
+		feedID:      feedID,
+		onConfigSet: onConfigSet,
+		onResync:    onResync,
+	}
+
+	h.listenersMux.Lock()
+	h.listeners[listener] = struct{}{}
+	h.listenersMux.Unlock()
+
+	return func() {
+		h.listenersMux.Lock()
+		delete(h.listeners, listener)
+		h.listenersMux.Unlock()
+	}
+}
+
+// Connected reports whether the subscription is alive and events are delivered.
+func (h *ConfigEventsHub) Connected() bool {
+	h.init()
+
+	h.listenersMux.RLock()
+	defer h.listenersMux.RUnlock()
+
+	return h.connected
+}
+
+func (h *ConfigEventsHub) setConnected(connected bool) {
+	h.listenersMux.Lock()
+	h.connected = connected
+	h.listenersMux.Unlock()
+}
+
+func (h *ConfigEventsHub) run() {
+	backoff := configEventsMinBackoff
+
+	for {
+		ts := time.Now()
+		err := h.listenEvents()
+		h.setConnected(false)
+
+		select {
+		case <-h.closeC:
+			return
+		default:
+		}
+
+		if time.Since(ts) > configEventsStallTimeout {
+			// the subscription has been alive for a while, not a flapping connection
+			backoff = configEventsMinBackoff
+		}
+
+		h.logger.WithError(err).Warningln("config events subscription dropped, reconnecting in", backoff)
+
+		select {
+		case <-h.closeC:
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > configEventsMaxBackoff {
+			backoff = configEventsMaxBackoff
+		}
+	}
+}
+
+// listenEvents returns when the subscription stalls or the hub is closed.
+// Subscription channels are never closed by the Tendermint client.
+func (h *ConfigEventsHub) listenEvents() error {
+	ctx, cancelFn := context.WithTimeout(context.Background(), configQueryTimeout)
+	blockEvents, err := h.TendermintClient.SubscribeEvents(ctx, configEventsSubscriber, configSetBlockQuery)
+	if err != nil {
+		cancelFn()
+		err = errors.Wrap(err, "failed to subscribe to block events")
+		return err
+	}
+
+	txEvents, err := h.TendermintClient.SubscribeEvents(ctx, configEventsSubscriber, configSetTxQuery)
+	cancelFn()
+	if err != nil {
+		h.unsubscribe(configSetBlockQuery)
+		err = errors.Wrap(err, "failed to subscribe to tx events")
+		return err
+	}
+
+	defer func() {
+		h.unsubscribe(configSetBlockQuery)
+		h.unsubscribe(configSetTxQuery)
+	}()
+
+	h.logger.Infoln("Subscribed to config events via Tendermint WS")
+	h.setConnected(true)
+
+	// listeners catch up with any changes missed while not subscribed
+	h.forEachListener(func(listener *configEventsListener) {
+		listener.onResync()
+	})
+
+	stallTimer := time.NewTimer(configEventsStallTimeout)
+	defer stallTimer.Stop()
+
+	for {
+		select {
+		case <-h.closeC:
+			return nil
+		case ev := <-blockEvents:
+			resetTimer(stallTimer, configEventsStallTimeout)
+			h.handleEvent(ev)
+		case ev := <-txEvents:
+			h.handleEvent(ev)
+		case <-stallTimer.C:
+			return errors.Errorf("no new blocks received in %s", configEventsStallTimeout)
+		}
+	}
+}
+
+func (h *ConfigEventsHub) unsubscribe(query string) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), configQueryTimeout)
+	defer cancelFn()
+
+	if err := h.TendermintClient.UnsubscribeEvents(ctx, configEventsSubscriber, query); err != nil {
+		h.logger.WithError(err).Debugln("failed to unsubscribe from", query)
+	}
+}
+
+func (h *ConfigEventsHub) forEachListener(fn func(listener *configEventsListener)) {
+	h.listenersMux.RLock()
+	defer h.listenersMux.RUnlock()
+
+	for listener := range h.listeners {
+		fn(listener)
+	}
+}
+
+func (h *ConfigEventsHub) handleEvent(ev ctypes.ResultEvent) {
+	var events []abci.Event
+
+	switch data := ev.Data.(type) {
+	case tmtypes.EventDataNewBlockHeader:
+		events = append(events, data.ResultBeginBlock.Events...)
+		events = append(events, data.ResultEndBlock.Events...)
+	case tmtypes.EventDataTx:
+		events = data.Result.Events
+	default:
+		return
+	}
+
+	for _, event := range events {
+		if event.Type != configSetEventType {
+			continue
+		}
+
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			h.logger.WithError(err).Warningln("failed to parse EventConfigSet")
+			continue
+		}
+
+		configSet, ok := typedEvent.(*chaintypes.EventConfigSet)
+		if !ok {
+			continue
+		}
+
+		// events without the feed ID are delivered to all feeds, they re-check the digest
+		var feedID string
+		if cfg := configSet.Config; cfg != nil && cfg.ModuleParams != nil {
+			feedID = cfg.ModuleParams.FeedId
+		}
+
+		h.forEachListener(func(listener *configEventsListener) {
+			if len(feedID) == 0 {
+				listener.onResync()
+			} else if listener.feedID == feedID {
+				listener.onConfigSet(configSet.ConfigDigest)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package injective
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+var _ = Describe("ConfigEventsHub", func() {
+	var (
+		tm  *fakeEventsClient
+		hub *ConfigEventsHub
+	)
+
+	BeforeEach(func() {
+		tm = newFakeEventsClient()
+		hub = &ConfigEventsHub{
+			TendermintClient: tm,
+		}
+	})
+
+	AfterEach(func() {
+		_ = hub.Close()
+	})
+
+	It("shares a single subscription and dispatches events by feed ID", func() {
+		var (
+			mux      sync.Mutex
+			received = make(map[string][][]byte)
+			resynced = make(map[string]int)
+		)
+
+		for _, feedID := range []string{"LINK/USDC", "INJ/USDT"} {
+			feedID := feedID
+
+			hub.Subscribe(feedID, func(configDigest []byte) {
+				mux.Lock()
+				received[feedID] = append(received[feedID], configDigest)
+				mux.Unlock()
+			}, func() {
+				mux.Lock()
+				resynced[feedID]++
+				mux.Unlock()
+			})
+		}
+
+		Expect(hub.Start()).To(BeNil())
+		Eventually(hub.Connected).Should(BeTrue())
+		Expect(tm.subscriptions()).To(ConsistOf(configSetBlockQuery, configSetTxQuery))
+
+		Eventually(func() map[string]int {
+			mux.Lock()
+			defer mux.Unlock()
+
+			return map[string]int{"LINK/USDC": resynced["LINK/USDC"], "INJ/USDT": resynced["INJ/USDT"]}
+		}).Should(Equal(map[string]int{"LINK/USDC": 1, "INJ/USDT": 1}))
+
+		digest := testConfigDigest(0x02)
+		tm.send(configSetTxQuery, configSetTxEvent("INJ/USDT", digest[:]))
+
+		Eventually(func() [][]byte {
+			mux.Lock()
+			defer mux.Unlock()
+
+			return received["INJ/USDT"]
+		}).Should(Equal([][]byte{digest[:]}))
+
+		mux.Lock()
+		Expect(received["LINK/USDC"]).To(BeEmpty())
+		mux.Unlock()
+	})
+
+	It("stops dispatching events to unsubscribed feeds", func() {
+		calls := make(chan []byte, 1)
+		unsubscribe := hub.Subscribe("LINK/USDC", func(configDigest []byte) {
+			calls <- configDigest
+		}, func() {})
+
+		Expect(hub.Start()).To(BeNil())
+		Eventually(hub.Connected).Should(BeTrue())
+
+		unsubscribe()
+
+		digest := testConfigDigest(0x03)
+		tm.send(configSetTxQuery, configSetTxEvent("LINK/USDC", digest[:]))
+		Consistently(calls, 100*time.Millisecond).ShouldNot(Receive())
+
+		// the shared subscription stays, other feeds may still use it
+		Expect(tm.subscriptions()).To(ConsistOf(configSetBlockQuery, configSetTxQuery))
+	})
+})
+
+func configSetTxEvent(feedID string, configDigest []byte) ctypes.ResultEvent {
+	event := mustTypedEvent(&chaintypes.EventConfigSet{
+		ConfigDigest: configDigest,
+		Config: &chaintypes.FeedConfig{
+			ModuleParams: &chaintypes.ModuleParams{
+				FeedId:    feedID,
+				MinAnswer: sdk.NewDec(1),
+				MaxAnswer: sdk.NewDec(2),
+			},
+		},
+	})
+
+	return ctypes.ResultEvent{
+		Data: tmtypes.EventDataTx{
+			TxResult: abci.TxResult{
+				Result: abci.ResponseDeliverTx{
+					Events: []abci.Event{event},
+				},
+			},
+		},
+	}
+}
+
+// fakeEventsClient keys subscriptions by query, like Tendermint WS client does.
+type fakeEventsClient struct {
+	*fakeTendermintClient
+
+	mux  sync.Mutex
+	subs map[string]chan ctypes.ResultEvent
+}
+
+func newFakeEventsClient() *fakeEventsClient {
+	return &fakeEventsClient{
+		fakeTendermintClient: newFakeTendermintClient(),
+		subs:                 make(map[string]chan ctypes.ResultEvent),
+	}
+}
+
+func (c *fakeEventsClient) SubscribeEvents(ctx context.Context, subscriber, query string) (<-chan ctypes.ResultEvent, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if _, ok := c.subs[query]; ok {
+		return nil, errors.New("already subscribed")
+	}
+
+	out := make(chan ctypes.ResultEvent, 10)
+	c.subs[query] = out
+
+	return out, nil
+}
+
+func (c *fakeEventsClient) UnsubscribeEvents(ctx context.Context, subscriber, query string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	delete(c.subs, query)
+	return nil
+}
+
+func (c *fakeEventsClient) subscriptions() []string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	queries := make([]string, 0, len(c.subs))
+	for query := range c.subs {
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
+func (c *fakeEventsClient) send(query string, ev ctypes.ResultEvent) {
+	c.mux.Lock()
+	out := c.subs[query]
+	c.mux.Unlock()
+
+	out <- ev
+}
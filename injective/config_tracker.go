@@ -2,9 +2,12 @@ package injective
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
@@ -18,6 +21,37 @@ type CosmosModuleConfigTracker struct {
 	FeedId           string
 	QueryClient      chaintypes.QueryClient
 	TendermintClient tmclient.TendermintClient
+
+	// ConfigEvents delivers EventConfigSet of the feed, shared between all feeds of the node.
+	ConfigEvents *ConfigEventsHub
+
+	// PollInterval sets how often the config digest is polled
+	// while Tendermint WS subscription is not available.
+	PollInterval time.Duration
+
+	initOnce  sync.Once
+	onceStart sync.Once
+	onceStop  sync.Once
+	notifyC   chan struct{}
+	closeC    chan struct{}
+
+	digestMux  sync.Mutex
+	lastDigest types.ConfigDigest
+
+	logger log.Logger
+}
+
+const configDefaultPollInterval = 15 * time.Second
+
+func (c *CosmosModuleConfigTracker) init() {
+	c.initOnce.Do(func() {
+		c.notifyC = make(chan struct{}, 1)
+		c.closeC = make(chan struct{})
+		c.logger = log.WithFields(log.Fields{
+			"svc":    "config_tracker",
+			"feedId": c.FeedId,
+		})
+	})
 }
 
 // Notify may optionally emit notification events when the contract's
@@ -27,10 +61,126 @@ type CosmosModuleConfigTracker struct {
 //
 // The returned channel should never be closed.
 func (c *CosmosModuleConfigTracker) Notify() <-chan struct{} {
-	// TODO: track events from Tendermint WS
+	c.init()
+
+	return c.notifyC
+}
+
+// Start begins tracking EventConfigSet for the feed using the shared config events
+// subscription. While the subscription is not available, the chain is polled.
+func (c *CosmosModuleConfigTracker) Start() error {
+	c.init()
+
+	if len(c.FeedId) == 0 {
+		err := errors.New("CosmosModuleConfigTracker has no FeedId set")
+		return err
+	}
+
+	if c.ConfigEvents == nil {
+		err := errors.New("cannot track config events: no ConfigEvents set")
+		return err
+	}
+
+	c.onceStart.Do(func() {
+		go c.trackEvents()
+	})
+
 	return nil
 }
 
+// Close stops tracking the config events. The Notify channel is never closed.
+func (c *CosmosModuleConfigTracker) Close() error {
+	c.init()
+
+	c.onceStop.Do(func() {
+		close(c.closeC)
+	})
+
+	return nil
+}
+
+func (c *CosmosModuleConfigTracker) trackEvents() {
+	unsubscribe := c.ConfigEvents.Subscribe(c.FeedId, c.handleConfigSet, c.resync)
+	defer unsubscribe()
+
+	// catch up with any changes before the subscription
+	c.checkLatestDigest()
+
+	interval := c.PollInterval
+	if interval <= 0 {
+		interval = configDefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.closeC:
+			return
+		case <-ticker.C:
+			if !c.ConfigEvents.Connected() {
+				c.checkLatestDigest()
+			}
+		}
+	}
+}
+
+func (c *CosmosModuleConfigTracker) handleConfigSet(configDigest []byte) {
+	c.logger.Infoln("Config change event received")
+
+	if len(configDigest) == len(types.ConfigDigest{}) {
+		c.digestMux.Lock()
+		copy(c.lastDigest[:], configDigest)
+		c.digestMux.Unlock()
+	}
+
+	c.notify()
+}
+
+// resync is called by the shared subscription, it must not block.
+func (c *CosmosModuleConfigTracker) resync() {
+	go c.checkLatestDigest()
+}
+
+func (c *CosmosModuleConfigTracker) checkLatestDigest() {
+	ctx, cancelFn := context.WithTimeout(context.Background(), configQueryTimeout)
+	defer cancelFn()
+
+	_, configDigest, err := c.LatestConfigDetails(ctx)
+	if err != nil {
+		c.logger.WithError(err).Debugln("failed to poll latest config details")
+		return
+	}
+
+	c.digestMux.Lock()
+	changed := configDigest != c.lastDigest
+	c.lastDigest = configDigest
+	c.digestMux.Unlock()
+
+	if changed {
+		c.notify()
+	}
+}
+
+func (c *CosmosModuleConfigTracker) notify() {
+	select {
+	case c.notifyC <- struct{}{}:
+	default:
+	}
+}
+
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+
+	t.Reset(d)
+}
+
 // LatestConfigDetails returns information about the latest configuration,
 // but not the configuration itself.
 func (c *CosmosModuleConfigTracker) LatestConfigDetails(
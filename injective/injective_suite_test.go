@@ -0,0 +1,18 @@
+package injective
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/xlab/suplog"
+)
+
+func TestInjective(t *testing.T) {
+	if !testing.Verbose() {
+		log.DefaultLogger.SetLevel(log.FatalLevel)
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Injective OCR2 Adapters Test Suite")
+}
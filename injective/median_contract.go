@@ -70,6 +70,9 @@ func (c *CosmosMedianReporter) LatestTransmissionDetails(
 	return
 }
 
+// LatestRoundRequested always returns zero values, as the OCR module has no way to request
+// a round. EventNewRound is emitted by every transmission, so taking it for a round request
+// would make the median plugin report each round regardless of deviation thresholds.
 func (c *CosmosMedianReporter) LatestRoundRequested(
 	ctx context.Context,
 	lookback time.Duration,
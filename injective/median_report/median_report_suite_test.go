@@ -0,0 +1,13 @@
+package median_report
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMedianReport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Median Report Codec Test Suite")
+}
@@ -16,9 +16,14 @@ import (
 
 var _ median.ReportCodec = ReportCodec{}
 
-type ReportCodec struct{}
+type ReportCodec struct {
+	// IncludeJuelsPerFeeCoin carries the median JuelsPerFeeCoin in reports. Enable only
+	// when the chain's OCR module Report has the field: the chain re-encodes the report
+	// to verify signatures and would drop an unknown field.
+	IncludeJuelsPerFeeCoin bool
+}
 
-func (ReportCodec) BuildReport(observations []median.ParsedAttributedObservation) (types.Report, error) {
+func (c ReportCodec) BuildReport(observations []median.ParsedAttributedObservation) (types.Report, error) {
 	if len(observations) == 0 {
 		err := errors.New("cannot build report from empty attributed observations")
 		return nil, err
@@ -34,6 +39,19 @@ func (ReportCodec) BuildReport(observations []median.ParsedAttributedObservation
 
 	timestamp := observations[len(observations)/2].Timestamp
 
+	// get median juelsPerFeeCoin
+	for i := range observations {
+		if observations[i].JuelsPerFeeCoin == nil {
+			observations[i].JuelsPerFeeCoin = new(big.Int)
+		}
+	}
+
+	sort.Slice(observations, func(i, j int) bool {
+		return observations[i].JuelsPerFeeCoin.Cmp(observations[j].JuelsPerFeeCoin) < 0
+	})
+
+	juelsPerFeeCoin := observations[len(observations)/2].JuelsPerFeeCoin
+
 	// sort by values
 	sort.Slice(observations, func(i, j int) bool {
 		return observations[i].Value.Cmp(observations[j].Value) < 0
@@ -45,6 +63,13 @@ func (ReportCodec) BuildReport(observations []median.ParsedAttributedObservation
 		Observations:          make([]sdk.Dec, 0, len(observations)),
 	}
 
+	// omitted when zero, so reports of feeds without a JuelsPerFeeCoin
+	// source stay byte-identical to the ones without the field.
+	if c.IncludeJuelsPerFeeCoin && juelsPerFeeCoin.Sign() != 0 {
+		juels := sdk.NewIntFromBigInt(juelsPerFeeCoin)
+		reportToPack.JuelsPerFeeCoin = &juels
+	}
+
 	for _, observation := range observations {
 		reportToPack.Observers = append(reportToPack.Observers, byte(observation.Observer))
 		reportToPack.Observations = append(reportToPack.Observations, sdk.NewDecFromBigInt(observation.Value))
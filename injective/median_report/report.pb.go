@@ -28,6 +28,7 @@ type Report struct {
 	ObservationsTimestamp int64                                    `protobuf:"varint,1,opt,name=observations_timestamp,json=observationsTimestamp,proto3" json:"observations_timestamp,omitempty"`
 	Observers             []byte                                   `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers,omitempty"`
 	Observations          []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,rep,name=observations,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"observations"`
+	JuelsPerFeeCoin       *github_com_cosmos_cosmos_sdk_types.Int  `protobuf:"bytes,4,opt,name=juels_per_fee_coin,json=juelsPerFeeCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"juels_per_fee_coin,omitempty"`
 }
 
 func (m *Report) Reset()         { *m = Report{} }
@@ -84,23 +85,26 @@ func init() {
 func init() { proto.RegisterFile("report.proto", fileDescriptor_3eedb623aa6ca98c) }
 
 var fileDescriptor_3eedb623aa6ca98c = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x1b, 0x0b, 0x83, 0x96, 0x9e, 0x8a, 0x4a, 0x11, 0xc9, 0x8a, 0x82, 0xf4, 0xe2, 0x57,
-	0x86, 0xf8, 0x02, 0xc3, 0x27, 0x28, 0x9e, 0xbc, 0x48, 0xda, 0x85, 0x2c, 0x6c, 0xc9, 0x17, 0x92,
-	0x6c, 0xe0, 0x5b, 0xf8, 0x2a, 0xbe, 0xc5, 0x8e, 0x3b, 0x8a, 0x87, 0x21, 0xed, 0x8b, 0x88, 0x8d,
-	0xe2, 0x3c, 0x25, 0x7c, 0x3f, 0x7e, 0x7f, 0xf8, 0xa5, 0x99, 0xe5, 0x06, 0xad, 0x07, 0x63, 0xd1,
-	0x63, 0x5e, 0x77, 0x4b, 0x26, 0xf5, 0x5a, 0xea, 0x15, 0x74, 0xe8, 0x14, 0x3a, 0x08, 0x58, 0x6a,
-	0x61, 0xd6, 0x1b, 0x21, 0x35, 0x28, 0xbe, 0x90, 0x4c, 0xc3, 0x76, 0xd6, 0x72, 0xcf, 0x66, 0x17,
-	0xa7, 0x02, 0x05, 0x8e, 0x6e, 0xfd, 0xfd, 0x0b, 0x33, 0x57, 0x6f, 0x24, 0x9d, 0x34, 0xa3, 0x98,
-	0xdf, 0xa7, 0xe7, 0xd8, 0x3a, 0x6e, 0xb7, 0xcc, 0x4b, 0xd4, 0xee, 0xd9, 0x4b, 0xc5, 0x9d, 0x67,
-	0xca, 0x14, 0xa4, 0x24, 0x55, 0xdc, 0x9c, 0x1d, 0xd3, 0xc7, 0x5f, 0x98, 0x5f, 0xa6, 0x49, 0x00,
-	0xdc, 0xba, 0xe2, 0xa4, 0x24, 0x55, 0xd6, 0xfc, 0x1d, 0xf2, 0x26, 0xcd, 0x8e, 0xb5, 0x22, 0x2e,
-	0xe3, 0x2a, 0x99, 0xc3, 0xee, 0x30, 0x8d, 0x3e, 0x0e, 0xd3, 0x1b, 0x21, 0xfd, 0x72, 0xd3, 0x42,
-	0x87, 0xaa, 0x0e, 0x15, 0x3f, 0xcf, 0xad, 0x5b, 0xac, 0x6a, 0xff, 0x62, 0xb8, 0x83, 0x07, 0xde,
-	0x35, 0xff, 0x36, 0xe6, 0xd7, 0xbb, 0x9e, 0x92, 0x7d, 0x4f, 0xc9, 0x67, 0x4f, 0xc9, 0xeb, 0x40,
-	0xa3, 0xfd, 0x40, 0xa3, 0xf7, 0x81, 0x46, 0x4f, 0x09, 0x40, 0x1d, 0xb2, 0xdb, 0xc9, 0xd8, 0x77,
-	0xf7, 0x15, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xec, 0x74, 0x50, 0x36, 0x01, 0x00, 0x00,
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x9b, 0x46, 0x0a, 0x59, 0x02, 0xc2, 0xa2, 0x12, 0x44, 0xda, 0xa2, 0x20, 0x45, 0x70,
+	0x43, 0x11, 0x5f, 0x20, 0x8a, 0xe0, 0x4d, 0x16, 0x41, 0xf0, 0x12, 0x92, 0x74, 0x4c, 0xd7, 0x66,
+	0xff, 0xb0, 0xbb, 0x29, 0xf4, 0x15, 0x3c, 0xf9, 0x58, 0x3d, 0xf6, 0x28, 0x1e, 0x8a, 0xe8, 0x8b,
+	0xb8, 0x26, 0x8a, 0xf5, 0xe6, 0xe1, 0x63, 0x87, 0xfd, 0xe6, 0xfb, 0x0d, 0x33, 0x28, 0xd4, 0xa0,
+	0xa4, 0xb6, 0x44, 0x69, 0x69, 0x25, 0x8e, 0x8b, 0x69, 0xc6, 0x44, 0xc5, 0xc4, 0x8c, 0x14, 0xd2,
+	0x70, 0x69, 0x48, 0x6b, 0x33, 0x51, 0xaa, 0xaa, 0x2e, 0x99, 0x20, 0x1c, 0x26, 0x2c, 0x13, 0x64,
+	0x3e, 0xce, 0xc1, 0x66, 0xe3, 0xfd, 0x9d, 0x52, 0x96, 0xb2, 0xc9, 0xc6, 0x5f, 0x55, 0x8b, 0x39,
+	0x7c, 0xea, 0xa2, 0x1e, 0x6d, 0x82, 0xf8, 0x1c, 0xed, 0xc9, 0xdc, 0x80, 0x9e, 0x67, 0x96, 0x49,
+	0x61, 0x52, 0xcb, 0x38, 0x18, 0x9b, 0x71, 0x15, 0x79, 0x43, 0x6f, 0xe4, 0xd3, 0xdd, 0x4d, 0xf7,
+	0xf6, 0xc7, 0xc4, 0x07, 0x28, 0x68, 0x0d, 0xd0, 0x26, 0xea, 0xba, 0xce, 0x90, 0xfe, 0x7e, 0x60,
+	0x8a, 0xc2, 0xcd, 0x58, 0xe4, 0x0f, 0xfd, 0x51, 0x90, 0x90, 0xe5, 0x7a, 0xd0, 0x79, 0x5d, 0x0f,
+	0x8e, 0x4b, 0x66, 0xa7, 0x75, 0xee, 0x36, 0xe0, 0x71, 0xbb, 0xc5, 0xf7, 0x73, 0x6a, 0x26, 0xb3,
+	0xd8, 0x2e, 0x14, 0x18, 0x72, 0x09, 0x05, 0xfd, 0xc3, 0xc0, 0x77, 0x08, 0x3f, 0xd6, 0x50, 0x99,
+	0x54, 0x81, 0x4e, 0x1f, 0x00, 0xd2, 0x42, 0x32, 0x11, 0x6d, 0xb9, 0xd1, 0x41, 0x72, 0xf2, 0x4f,
+	0xea, 0xb5, 0xb0, 0x74, 0xbb, 0xa1, 0xdc, 0x80, 0xbe, 0x02, 0xb8, 0x70, 0x88, 0xe4, 0x68, 0xf9,
+	0xde, 0xf7, 0x56, 0x4e, 0x6f, 0x4e, 0xcf, 0x1f, 0xfd, 0xce, 0xca, 0xe9, 0xc5, 0xe9, 0x3e, 0x20,
+	0x24, 0x6e, 0xef, 0x99, 0xf7, 0x9a, 0xc3, 0x9d, 0x7d, 0x02, 0xa5, 0xe1, 0x3d, 0x7a, 0x8f, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Report) Marshal() (dAtA []byte, err error) {
@@ -123,6 +127,18 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JuelsPerFeeCoin != nil {
+		{
+			size := m.JuelsPerFeeCoin.Size()
+			i -= size
+			if _, err := m.JuelsPerFeeCoin.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintReport(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Observations) > 0 {
 		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -182,6 +198,10 @@ func (m *Report) Size() (n int) {
 			n += 1 + l + sovReport(uint64(l))
 		}
 	}
+	if m.JuelsPerFeeCoin != nil {
+		l = m.JuelsPerFeeCoin.Size()
+		n += 1 + l + sovReport(uint64(l))
+	}
 	return n
 }
 
@@ -309,6 +329,42 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JuelsPerFeeCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.JuelsPerFeeCoin = &v
+			if err := m.JuelsPerFeeCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReport(dAtA[iNdEx:])
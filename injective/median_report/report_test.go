@@ -0,0 +1,121 @@
+package median_report
+
+import (
+	"math/big"
+
+	proto "github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+var _ = Describe("ReportCodec", func() {
+	var codec ReportCodec
+
+	newObservations := func(juels func(idx int) *big.Int) []median.ParsedAttributedObservation {
+		values := []int64{300, 100, 500, 200}
+		timestamps := []uint32{1003, 1001, 1004, 1002}
+
+		observations := make([]median.ParsedAttributedObservation, 0, len(values))
+		for idx := range values {
+			observations = append(observations, median.ParsedAttributedObservation{
+				Timestamp:       timestamps[idx],
+				Value:           big.NewInt(values[idx]),
+				JuelsPerFeeCoin: juels(idx),
+				Observer:        commontypes.OracleID(idx),
+			})
+		}
+
+		return observations
+	}
+
+	withoutJuels := func(idx int) *big.Int {
+		return nil
+	}
+
+	withJuels := func(idx int) *big.Int {
+		return big.NewInt(int64(idx+1) * 1e18)
+	}
+
+	It("builds a report and decodes the median back", func() {
+		observations := newObservations(withoutJuels)
+
+		report, err := codec.BuildReport(observations)
+		Expect(err).To(BeNil())
+
+		value, err := codec.MedianFromReport(report)
+		Expect(err).To(BeNil())
+		Expect(value.Int64()).To(Equal(int64(300)))
+
+		reportRaw, err := codec.ParseReport(report)
+		Expect(err).To(BeNil())
+		Expect(reportRaw.ObservationsTimestamp).To(Equal(int64(1003)))
+		Expect(reportRaw.Observers).To(Equal([]byte{1, 3, 0, 2}))
+		Expect(reportRaw.Observations).To(HaveLen(4))
+		Expect(reportRaw.Observations[0].BigInt().Int64()).To(Equal(int64(100)))
+
+		// observations of the caller are not re-ordered
+		Expect(observations[0].Value.Int64()).To(Equal(int64(300)))
+	})
+
+	It("builds the same report with and without JuelsPerFeeCoin, unless enabled", func() {
+		reportWithout, err := codec.BuildReport(newObservations(withoutJuels))
+		Expect(err).To(BeNil())
+
+		reportWith, err := codec.BuildReport(newObservations(withJuels))
+		Expect(err).To(BeNil())
+		Expect(reportWith).To(Equal(reportWithout))
+
+		value, err := codec.MedianFromReport(reportWith)
+		Expect(err).To(BeNil())
+		Expect(value.Int64()).To(Equal(int64(300)))
+	})
+
+	It("carries the median JuelsPerFeeCoin when enabled", func() {
+		juelsCodec := ReportCodec{IncludeJuelsPerFeeCoin: true}
+
+		report, err := juelsCodec.BuildReport(newObservations(withJuels))
+		Expect(err).To(BeNil())
+
+		reportRaw, err := juelsCodec.ParseReport(report)
+		Expect(err).To(BeNil())
+		Expect(reportRaw.JuelsPerFeeCoin).ToNot(BeNil())
+		Expect(reportRaw.JuelsPerFeeCoin.BigInt().String()).To(Equal("3000000000000000000"))
+		Expect(reportRaw.Observations[0].BigInt().Int64()).To(Equal(int64(100)))
+
+		// zero is omitted, the report stays the same as without the field
+		reportZero, err := juelsCodec.BuildReport(newObservations(withoutJuels))
+		Expect(err).To(BeNil())
+
+		reportWithout, err := codec.BuildReport(newObservations(withoutJuels))
+		Expect(err).To(BeNil())
+		Expect(reportZero).To(Equal(reportWithout))
+	})
+
+	It("builds reports the chain re-encodes identically", func() {
+		for _, juelsCodec := range []ReportCodec{codec, {IncludeJuelsPerFeeCoin: true}} {
+			for _, juels := range []func(int) *big.Int{withoutJuels, withJuels} {
+				report, err := juelsCodec.BuildReport(newObservations(juels))
+				Expect(err).To(BeNil())
+
+				var chainReport chaintypes.Report
+				Expect(proto.Unmarshal(report, &chainReport)).To(BeNil())
+
+				reencoded, err := proto.Marshal(&chainReport)
+				Expect(err).To(BeNil())
+				Expect([]byte(report)).To(Equal(reencoded))
+			}
+		}
+	})
+
+	It("rejects empty observations and reports", func() {
+		_, err := codec.BuildReport(nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = codec.MedianFromReport(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
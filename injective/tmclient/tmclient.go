@@ -3,7 +3,9 @@ package tmclient
 import (
 	"context"
 	"strings"
+	"sync"
 
+	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -16,13 +18,23 @@ type TendermintClient interface {
 	GetBlock(ctx context.Context, height int64) (*tmctypes.ResultBlock, error)
 	GetLatestBlockHeight(ctx context.Context) (int64, error)
 	GetTxs(ctx context.Context, block *tmctypes.ResultBlock) ([]*ctypes.ResultTx, error)
+	GetTx(ctx context.Context, hash []byte) (*ctypes.ResultTx, error)
 	GetValidatorSet(ctx context.Context, height int64) (*tmctypes.ResultValidators, error)
+	SubscribeEvents(ctx context.Context, subscriber, query string) (<-chan ctypes.ResultEvent, error)
+	UnsubscribeEvents(ctx context.Context, subscriber, query string) error
 }
 
+var ErrTxNotFound = errors.New("tx not found")
+
 type tmClient struct {
 	rpcClient rpcclient.Client
+	wsMux     *sync.Mutex
 }
 
+// eventsOutCapacity is the buffer size of subscription channels, so
+// a slow consumer doesn't immediately get evicted by the RPC node.
+const eventsOutCapacity = 100
+
 func NewRPCClient(rpcNodeAddr string) TendermintClient {
 	rpcClient, err := rpchttp.NewWithTimeout(rpcNodeAddr, "/websocket", 10)
 	if err != nil {
@@ -31,6 +43,7 @@ func NewRPCClient(rpcNodeAddr string) TendermintClient {
 
 	return &tmClient{
 		rpcClient: rpcClient,
+		wsMux:     new(sync.Mutex),
 	}
 }
 
@@ -72,8 +85,43 @@ func (c *tmClient) GetTxs(ctx context.Context, block *tmctypes.ResultBlock) ([]*
 	return txs, nil
 }
 
+// GetTx queries for a transaction by its hash. ErrTxNotFound is returned
+// if the transaction is not included in a block yet.
+func (c *tmClient) GetTx(ctx context.Context, hash []byte) (*ctypes.ResultTx, error) {
+	tx, err := c.rpcClient.Tx(ctx, hash, false)
+	if err != nil {
+		if strings.HasSuffix(err.Error(), "not found") {
+			return nil, ErrTxNotFound
+		}
+
+		return nil, err
+	}
+
+	return tx, nil
+}
+
 // GetValidatorSet returns all the known Tendermint validators for a given block
 // height. An error is returned if the query fails.
 func (c *tmClient) GetValidatorSet(ctx context.Context, height int64) (*tmctypes.ResultValidators, error) {
 	return c.rpcClient.Validators(ctx, &height, nil, nil)
 }
+
+// SubscribeEvents subscribes to events matching the query via Tendermint websocket.
+// The websocket connection is established upon first subscription.
+func (c *tmClient) SubscribeEvents(ctx context.Context, subscriber, query string) (<-chan ctypes.ResultEvent, error) {
+	c.wsMux.Lock()
+	if !c.rpcClient.IsRunning() {
+		if err := c.rpcClient.Start(); err != nil {
+			c.wsMux.Unlock()
+			return nil, err
+		}
+	}
+	c.wsMux.Unlock()
+
+	return c.rpcClient.Subscribe(ctx, subscriber, query, eventsOutCapacity)
+}
+
+// UnsubscribeEvents cancels a subscription made by SubscribeEvents.
+func (c *tmClient) UnsubscribeEvents(ctx context.Context, subscriber, query string) error {
+	return c.rpcClient.Unsubscribe(ctx, subscriber, query)
+}
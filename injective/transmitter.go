@@ -3,13 +3,18 @@ package injective
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 	log "github.com/xlab/suplog"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/InjectiveLabs/chainlink-injective/injective/median_report"
+	"github.com/InjectiveLabs/chainlink-injective/injective/txbroadcaster"
 	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
 	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
 )
 
@@ -17,9 +22,20 @@ var _ types.ContractTransmitter = &CosmosModuleTransmitter{}
 
 type CosmosModuleTransmitter struct {
 	FeedId       string
+	JobID        string
 	QueryClient  chaintypes.QueryClient
 	CosmosClient chainclient.CosmosClient
 	ReportCodec  median_report.ReportCodec
+
+	// TxBroadcaster handles gas estimation, fee bumps and retries of transmissions,
+	// if not set, the Tx is broadcasted once using CosmosClient.
+	TxBroadcaster txbroadcaster.TxBroadcaster
+
+	// Confirmations tracks inclusion of the sent Txs, optional.
+	Confirmations *TxConfirmationTracker
+
+	// OnTransmit is called after each transmission attempt, optional.
+	OnTransmit func(reportCtx types.ReportContext, txHash string, err error)
 }
 
 func (c *CosmosModuleTransmitter) FromAccount() types.Account {
@@ -32,12 +48,60 @@ func (c *CosmosModuleTransmitter) Transmit(
 	reportCtx types.ReportContext,
 	report types.Report,
 	signatures []types.AttributedOnchainSignature,
-) error {
+) (err error) {
+	var txHash string
+	var txResp *sdk.TxResponse
+	var sentAt time.Time
+	var gasUsed int64
+	var skipped bool
+	var unconfirmed bool
+
+	defer func() {
+		metricTags := metrics.Tags{
+			"job":  c.JobID,
+			"feed": c.FeedId,
+		}
+
+		if skipped {
+			metrics.ReportTransmissionSkipped(metricTags)
+			return
+		} else if unconfirmed {
+			metrics.ReportTransmissionPending(metricTags)
+		} else if err != nil {
+			metrics.ReportTransmissionFailed(metricTags)
+		} else {
+			metrics.ReportTransmissionSent(gasUsed, metricTags)
+		}
+
+		if c.OnTransmit != nil {
+			c.OnTransmit(reportCtx, txHash, err)
+		}
+
+		// Txs included on chain are recorded even if failed in DeliverTx, so reverted
+		// transmissions are kept in the history as well.
+		if c.Confirmations != nil && len(txHash) > 0 && (txResp.Height > 0 || unconfirmed || err == nil) {
+			c.Confirmations.Record(reportCtx, txResp, sentAt)
+		}
+	}()
+
 	if len(c.FeedId) == 0 {
 		err := errors.New("CosmosModuleTransmitter has no FeedId set")
 		return err
 	}
 
+	if transmitted, err := c.isTransmitted(ctx, reportCtx); err != nil {
+		log.WithError(err).Warningln("failed to check latest transmission, broadcasting anyway")
+	} else if transmitted {
+		log.WithFields(log.Fields{
+			"feedId": c.FeedId,
+			"epoch":  reportCtx.Epoch,
+			"round":  reportCtx.Round,
+		}).Infoln("report already transmitted by another oracle, skipping Tx")
+
+		skipped = true
+		return nil
+	}
+
 	// TODO: design how to decouple Cosmos reporting from reportingplugins of OCR2
 	// The reports are not necessarily numeric (see: titlerequest).
 	reportRaw, err := c.ReportCodec.ParseReport(report)
@@ -56,6 +120,7 @@ func (c *CosmosModuleTransmitter) Transmit(
 			ObservationsTimestamp: reportRaw.ObservationsTimestamp,
 			Observers:             reportRaw.Observers,
 			Observations:          reportRaw.Observations,
+			JuelsPerFeeCoin:       reportRaw.JuelsPerFeeCoin,
 		},
 		Signatures: make([][]byte, 0, len(signatures)),
 	}
@@ -64,8 +129,29 @@ func (c *CosmosModuleTransmitter) Transmit(
 		msgTransmit.Signatures = append(msgTransmit.Signatures, sig.Signature)
 	}
 
-	txResp, err := c.CosmosClient.SyncBroadcastMsg(msgTransmit)
-	if err != nil {
+	sentAt = time.Now()
+	if c.TxBroadcaster != nil {
+		// retries within the ctx deadline, i.e. ContractTransmitterTransmitTimeout
+		txResp, err = c.TxBroadcaster.BroadcastMsgs(ctx, msgTransmit)
+	} else {
+		txResp, err = c.CosmosClient.SyncBroadcastMsg(msgTransmit)
+	}
+
+	if txResp != nil {
+		txHash = txResp.TxHash
+		gasUsed = txResp.GasUsed
+	}
+
+	if errors.Cause(err) == txbroadcaster.ErrTxUnconfirmed && len(txHash) > 0 {
+		// the Tx is in mempool and may still be included, its result is up to Confirmations
+		log.WithFields(log.Fields{
+			"txHash":      txHash,
+			"transmitter": c.CosmosClient.FromAddress().String(),
+		}).Warningln("Cosmos Tx sent, but not included within the transmit timeout")
+
+		unconfirmed = true
+		return nil
+	} else if err != nil {
 		return err
 	}
 
@@ -82,6 +168,47 @@ func (c *CosmosModuleTransmitter) Transmit(
 	return nil
 }
 
+// isTransmitted checks whether the chain already has a transmission for the same config digest
+// with an equal or newer epoch and round, so the report is redundant.
+func (c *CosmosModuleTransmitter) isTransmitted(ctx context.Context, reportCtx types.ReportContext) (bool, error) {
+	if c.QueryClient == nil {
+		return false, nil
+	}
+
+	resp, err := c.QueryClient.LatestTransmissionDetails(ctx, &chaintypes.QueryLatestTransmissionDetailsRequest{
+		FeedId: c.FeedId,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if resp.ConfigDigest == nil || resp.EpochAndRound == nil {
+		return false, nil
+	}
+
+	if configDigestFromBytes(resp.ConfigDigest) != reportCtx.ConfigDigest {
+		return false, nil
+	}
+
+	epoch := uint32(resp.EpochAndRound.Epoch)
+	round := uint8(resp.EpochAndRound.Round)
+
+	if epoch > reportCtx.Epoch {
+		return true, nil
+	}
+
+	return epoch == reportCtx.Epoch && round >= reportCtx.Round, nil
+}
+
+// Close stops tracking confirmations of the sent Txs.
+func (c *CosmosModuleTransmitter) Close() error {
+	if c.Confirmations != nil {
+		return c.Confirmations.Close()
+	}
+
+	return nil
+}
+
 func (c *CosmosModuleTransmitter) LatestConfigDigestAndEpoch(
 	ctx context.Context,
 ) (
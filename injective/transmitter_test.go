@@ -0,0 +1,140 @@
+package injective
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/InjectiveLabs/chainlink-injective/injective/median_report"
+	"github.com/InjectiveLabs/chainlink-injective/injective/txbroadcaster"
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+)
+
+var _ = Describe("CosmosModuleTransmitter", func() {
+	var (
+		broadcaster *fakeTxBroadcaster
+		confirmed   chan *TxConfirmation
+		transmitter *CosmosModuleTransmitter
+		reportCtx   types.ReportContext
+		report      types.Report
+	)
+
+	BeforeEach(func() {
+		broadcaster = &fakeTxBroadcaster{}
+		confirmed = make(chan *TxConfirmation, 1)
+
+		transmitter = &CosmosModuleTransmitter{
+			FeedId:        "LINK/USDC",
+			JobID:         "job",
+			CosmosClient:  &fakeCosmosClient{},
+			ReportCodec:   median_report.ReportCodec{},
+			TxBroadcaster: broadcaster,
+			Confirmations: &TxConfirmationTracker{
+				JobID:            "job",
+				FeedId:           "LINK/USDC",
+				TendermintClient: newFakeTendermintClient(),
+				PollInterval:     10 * time.Millisecond,
+				Timeout:          100 * time.Millisecond,
+				OnConfirmation: func(conf *TxConfirmation) {
+					confirmed <- conf
+				},
+			},
+		}
+
+		reportCtx = types.ReportContext{
+			ReportTimestamp: types.ReportTimestamp{
+				ConfigDigest: testConfigDigest(0x01),
+				Epoch:        3,
+				Round:        2,
+			},
+		}
+
+		var err error
+		report, err = median_report.ReportCodec{}.BuildReport([]median.ParsedAttributedObservation{{
+			Timestamp:       uint32(time.Now().Unix()),
+			Value:           big.NewInt(100),
+			JuelsPerFeeCoin: big.NewInt(1),
+			Observer:        commontypes.OracleID(0),
+		}})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		Expect(transmitter.Close()).To(BeNil())
+	})
+
+	It("records an included Tx", func() {
+		broadcaster.txResp = &sdk.TxResponse{TxHash: "0A0B", Height: 12}
+
+		Expect(transmitter.Transmit(context.Background(), reportCtx, report, nil)).To(BeNil())
+
+		var conf *TxConfirmation
+		Expect(confirmed).To(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationIncluded))
+		Expect(conf.Height).To(Equal(int64(12)))
+	})
+
+	It("records a Tx included on chain but failed in DeliverTx", func() {
+		broadcaster.txResp = &sdk.TxResponse{TxHash: "0C0D", Height: 12, Code: 5, RawLog: "stale report"}
+		broadcaster.err = errors.New("Tx failed in DeliverTx: code 5 (ocr): stale report")
+
+		Expect(transmitter.Transmit(context.Background(), reportCtx, report, nil)).ToNot(BeNil())
+
+		var conf *TxConfirmation
+		Expect(confirmed).To(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationFailed))
+		Expect(conf.Height).To(Equal(int64(12)))
+		Expect(conf.Code).To(Equal(uint32(5)))
+		Expect(conf.Epoch).To(Equal(uint32(3)))
+	})
+
+	It("doesn't record a Tx rejected before reaching the chain", func() {
+		broadcaster.err = errors.New("failed to simulate Tx: stale report")
+
+		Expect(transmitter.Transmit(context.Background(), reportCtx, report, nil)).ToNot(BeNil())
+		Consistently(confirmed, 200*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("tracks a Tx not included within the transmit timeout", func() {
+		broadcaster.txResp = &sdk.TxResponse{TxHash: "0E0F"}
+		broadcaster.err = errors.Wrap(txbroadcaster.ErrTxUnconfirmed, "Tx 0E0F")
+
+		Expect(transmitter.Transmit(context.Background(), reportCtx, report, nil)).To(BeNil())
+
+		var conf *TxConfirmation
+		Eventually(confirmed).Should(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationUnconfirmed))
+	})
+})
+
+var testTransmitterAddress = sdk.AccAddress("transmitter_________")
+
+// fakeTxBroadcaster returns the scripted result for every broadcast.
+type fakeTxBroadcaster struct {
+	txResp *sdk.TxResponse
+	err    error
+}
+
+func (b *fakeTxBroadcaster) FromAddress() sdk.AccAddress {
+	return testTransmitterAddress
+}
+
+func (b *fakeTxBroadcaster) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	return b.txResp, b.err
+}
+
+type fakeCosmosClient struct {
+	chainclient.CosmosClient
+}
+
+func (c *fakeCosmosClient) FromAddress() sdk.AccAddress {
+	return testTransmitterAddress
+}
@@ -0,0 +1,317 @@
+package injective
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	log "github.com/xlab/suplog"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/InjectiveLabs/chainlink-injective/injective/tmclient"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+type TxConfirmationStatus string
+
+const (
+	TxConfirmationIncluded    TxConfirmationStatus = "included"
+	TxConfirmationFailed      TxConfirmationStatus = "failed"
+	TxConfirmationUnconfirmed TxConfirmationStatus = "unconfirmed"
+)
+
+// TxConfirmation is the final result of a transmission Tx.
+type TxConfirmation struct {
+	TxHash       string
+	ConfigDigest types.ConfigDigest
+	Epoch        uint32
+	Round        uint8
+
+	Status TxConfirmationStatus
+	Height int64
+	Code   uint32
+	Log    string
+
+	// GasWanted, GasUsed and Fees are totals of the whole Tx. A batched Tx carries
+	// transmissions of several feeds, NumMsgs of them, each reporting the same totals.
+	GasWanted int64
+	GasUsed   int64
+	Fees      sdk.Coins
+	NumMsgs   int
+
+	SentAt      time.Time
+	ConfirmedAt time.Time
+}
+
+// TxConfirmationTracker reports DeliverTx results of transmitted Txs. Results already known
+// to the broadcaster are recorded as is, otherwise Tendermint is polled for the Tx inclusion.
+type TxConfirmationTracker struct {
+	JobID            string
+	FeedId           string
+	TendermintClient tmclient.TendermintClient
+
+	// TxDecoder is used to read the fees paid, optional.
+	TxDecoder sdk.TxDecoder
+
+	// PollInterval sets how often the Tx inclusion is checked.
+	PollInterval time.Duration
+	// Timeout sets how long to wait for the Tx inclusion, until it's unconfirmed.
+	Timeout time.Duration
+
+	// OnConfirmation is called with the final result of each tracked Tx, optional.
+	OnConfirmation func(conf *TxConfirmation)
+
+	initOnce sync.Once
+	onceStop sync.Once
+	closeC   chan struct{}
+	wg       sync.WaitGroup
+
+	metricTags metrics.Tags
+	logger     log.Logger
+}
+
+const (
+	defaultTxConfirmationPollInterval = time.Second
+	defaultTxConfirmationTimeout      = time.Minute
+	txQueryTimeout                    = 10 * time.Second
+)
+
+func (c *TxConfirmationTracker) init() {
+	c.initOnce.Do(func() {
+		if c.PollInterval == 0 {
+			c.PollInterval = defaultTxConfirmationPollInterval
+		}
+
+		if c.Timeout == 0 {
+			c.Timeout = defaultTxConfirmationTimeout
+		}
+
+		c.closeC = make(chan struct{})
+		c.metricTags = metrics.Tags{
+			"job":  c.JobID,
+			"feed": c.FeedId,
+		}
+		c.logger = log.WithFields(log.Fields{
+			"svc":    "tx_confirmations",
+			"jobID":  c.JobID,
+			"feedId": c.FeedId,
+		})
+	})
+}
+
+// Track starts polling for inclusion of the Tx in background. Txs tracked after Close are ignored.
+func (c *TxConfirmationTracker) Track(reportCtx types.ReportContext, txHash string) {
+	c.init()
+
+	select {
+	case <-c.closeC:
+		return
+	default:
+	}
+
+	c.track(newTxConfirmation(reportCtx, txHash, time.Now()))
+}
+
+// Record reports the result of a Tx returned by the broadcaster. If the Tx hasn't been
+// included in a block yet, it's tracked like in Track. Txs recorded after Close are ignored.
+func (c *TxConfirmationTracker) Record(reportCtx types.ReportContext, txResp *sdk.TxResponse, sentAt time.Time) {
+	c.init()
+
+	select {
+	case <-c.closeC:
+		return
+	default:
+	}
+
+	conf := newTxConfirmation(reportCtx, txResp.TxHash, sentAt)
+	if txResp.Height == 0 {
+		c.track(conf)
+		return
+	}
+
+	c.fillResultFromResponse(conf, txResp)
+	c.report(conf)
+}
+
+func newTxConfirmation(reportCtx types.ReportContext, txHash string, sentAt time.Time) *TxConfirmation {
+	return &TxConfirmation{
+		TxHash:       txHash,
+		ConfigDigest: reportCtx.ConfigDigest,
+		Epoch:        reportCtx.Epoch,
+		Round:        reportCtx.Round,
+		Status:       TxConfirmationUnconfirmed,
+		SentAt:       sentAt,
+	}
+}
+
+func (c *TxConfirmationTracker) track(conf *TxConfirmation) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		if !c.awaitConfirmation(conf) {
+			return
+		}
+
+		c.report(conf)
+	}()
+}
+
+// Close stops tracking, pending Txs are dropped without a result.
+func (c *TxConfirmationTracker) Close() error {
+	c.init()
+
+	c.onceStop.Do(func() {
+		close(c.closeC)
+	})
+
+	c.wg.Wait()
+	return nil
+}
+
+// awaitConfirmation polls for the Tx until it's included or the timeout passes.
+// Returns false if the tracker has been closed meanwhile.
+func (c *TxConfirmationTracker) awaitConfirmation(conf *TxConfirmation) bool {
+	hash, err := hex.DecodeString(conf.TxHash)
+	if err != nil {
+		c.logger.WithError(err).Warningln("cannot track Tx with malformed hash", conf.TxHash)
+		return true
+	}
+
+	t := time.NewTicker(c.PollInterval)
+	defer t.Stop()
+
+	timeout := time.NewTimer(c.Timeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case <-c.closeC:
+			return false
+		case <-timeout.C:
+			return true
+		case <-t.C:
+		}
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), txQueryTimeout)
+		resultTx, err := c.TendermintClient.GetTx(ctx, hash)
+		cancelFn()
+
+		if err != nil {
+			if errors.Cause(err) != tmclient.ErrTxNotFound {
+				c.logger.WithError(err).Debugln("failed to query Tx", conf.TxHash)
+			}
+
+			continue
+		}
+
+		c.fillResult(conf, resultTx)
+		return true
+	}
+}
+
+func (c *TxConfirmationTracker) fillResult(conf *TxConfirmation, resultTx *ctypes.ResultTx) {
+	conf.Height = resultTx.Height
+	conf.Code = resultTx.TxResult.Code
+	conf.Log = resultTx.TxResult.Log
+	conf.GasWanted = resultTx.TxResult.GasWanted
+	conf.GasUsed = resultTx.TxResult.GasUsed
+	conf.ConfirmedAt = time.Now()
+
+	if conf.Code == 0 {
+		conf.Status = TxConfirmationIncluded
+	} else {
+		conf.Status = TxConfirmationFailed
+	}
+
+	if c.TxDecoder == nil {
+		return
+	}
+
+	tx, err := c.TxDecoder(resultTx.Tx)
+	if err != nil {
+		c.logger.WithError(err).Debugln("failed to decode Tx", conf.TxHash)
+		return
+	}
+
+	conf.NumMsgs = len(tx.GetMsgs())
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		conf.Fees = feeTx.GetFee()
+	}
+}
+
+// fillResultFromResponse fills the result from TxResponse of the Cosmos Tx service,
+// which has the Tx packed as Any, so it's read without the TxDecoder.
+func (c *TxConfirmationTracker) fillResultFromResponse(conf *TxConfirmation, txResp *sdk.TxResponse) {
+	conf.Height = txResp.Height
+	conf.Code = txResp.Code
+	conf.Log = txResp.RawLog
+	conf.GasWanted = txResp.GasWanted
+	conf.GasUsed = txResp.GasUsed
+	conf.ConfirmedAt = time.Now()
+
+	if conf.Code == 0 {
+		conf.Status = TxConfirmationIncluded
+	} else {
+		conf.Status = TxConfirmationFailed
+	}
+
+	if txResp.Tx == nil {
+		return
+	}
+
+	var tx txtypes.Tx
+	if err := tx.Unmarshal(txResp.Tx.Value); err != nil {
+		c.logger.WithError(err).Debugln("failed to unmarshal Tx", conf.TxHash)
+		return
+	}
+
+	if tx.Body != nil {
+		conf.NumMsgs = len(tx.Body.Messages)
+	}
+
+	if tx.AuthInfo != nil && tx.AuthInfo.Fee != nil {
+		conf.Fees = tx.AuthInfo.Fee.Amount
+	}
+}
+
+func (c *TxConfirmationTracker) report(conf *TxConfirmation) {
+	logger := c.logger.WithFields(log.Fields{
+		"txHash": conf.TxHash,
+		"epoch":  conf.Epoch,
+		"round":  conf.Round,
+	})
+
+	switch conf.Status {
+	case TxConfirmationIncluded:
+		metrics.ReportTransmissionConfirmed(conf.ConfirmedAt.Sub(conf.SentAt), conf.GasUsed, c.metricTags)
+
+		logger.WithFields(log.Fields{
+			"height":    conf.Height,
+			"txGasUsed": conf.GasUsed,
+			"txFees":    conf.Fees.String(),
+			"txNumMsgs": conf.NumMsgs,
+		}).Infoln("transmission Tx included")
+	case TxConfirmationFailed:
+		metrics.ReportTransmissionReverted(c.metricTags)
+
+		logger.WithFields(log.Fields{
+			"height": conf.Height,
+			"code":   conf.Code,
+		}).Warningln("transmission Tx failed:", conf.Log)
+	default:
+		metrics.ReportTransmissionUnconfirmed(c.metricTags)
+
+		logger.Warningln("transmission Tx not included within", c.Timeout)
+	}
+
+	if c.OnConfirmation != nil {
+		c.OnConfirmation(conf)
+	}
+}
@@ -0,0 +1,243 @@
+package injective
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/InjectiveLabs/chainlink-injective/injective/tmclient"
+)
+
+var _ = Describe("TxConfirmationTracker", func() {
+	var (
+		tm        *fakeTendermintClient
+		tracker   *TxConfirmationTracker
+		confirmed chan *TxConfirmation
+		reportCtx types.ReportContext
+	)
+
+	BeforeEach(func() {
+		tm = newFakeTendermintClient()
+		confirmed = make(chan *TxConfirmation, 1)
+
+		tracker = &TxConfirmationTracker{
+			JobID:            "job",
+			FeedId:           "LINK/USDC",
+			TendermintClient: tm,
+			PollInterval:     10 * time.Millisecond,
+			Timeout:          200 * time.Millisecond,
+			OnConfirmation: func(conf *TxConfirmation) {
+				confirmed <- conf
+			},
+		}
+
+		reportCtx = types.ReportContext{
+			ReportTimestamp: types.ReportTimestamp{
+				Epoch: 3,
+				Round: 2,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(tracker.Close()).To(BeNil())
+	})
+
+	addTx := func(hash []byte, result abci.ResponseDeliverTx) {
+		tm.addBlock(time.Now(), &ctypes.ResultTx{
+			Hash:     hash,
+			TxResult: result,
+		})
+	}
+
+	It("reports an included Tx", func() {
+		hash := []byte{0x01, 0x02}
+		addTx(hash, abci.ResponseDeliverTx{
+			GasWanted: 200000,
+			GasUsed:   150000,
+		})
+
+		tracker.Track(reportCtx, hex.EncodeToString(hash))
+
+		var conf *TxConfirmation
+		Eventually(confirmed).Should(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationIncluded))
+		Expect(conf.Height).To(Equal(int64(1)))
+		Expect(conf.GasUsed).To(Equal(int64(150000)))
+		Expect(conf.Epoch).To(Equal(uint32(3)))
+		Expect(conf.Round).To(Equal(uint8(2)))
+		Expect(conf.ConfirmedAt.IsZero()).To(BeFalse())
+	})
+
+	It("reports a Tx failed in DeliverTx", func() {
+		hash := []byte{0x03, 0x04}
+		addTx(hash, abci.ResponseDeliverTx{
+			Code: 5,
+			Log:  "insufficient funds",
+		})
+
+		tracker.Track(reportCtx, hex.EncodeToString(hash))
+
+		var conf *TxConfirmation
+		Eventually(confirmed).Should(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationFailed))
+		Expect(conf.Code).To(Equal(uint32(5)))
+		Expect(conf.Log).To(Equal("insufficient funds"))
+	})
+
+	It("reports a Tx not included within the timeout", func() {
+		tracker.Track(reportCtx, hex.EncodeToString([]byte{0x05}))
+
+		var conf *TxConfirmation
+		Eventually(confirmed).Should(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationUnconfirmed))
+		Expect(conf.Height).To(BeZero())
+	})
+
+	It("records the broadcaster's result without polling, with totals of a batched Tx", func() {
+		tx := &txtypes.Tx{
+			Body: &txtypes.TxBody{
+				Messages: []*codectypes.Any{{}, {}},
+			},
+			AuthInfo: &txtypes.AuthInfo{
+				Fee: &txtypes.Fee{
+					Amount: sdk.NewCoins(sdk.NewInt64Coin("inj", 1000)),
+				},
+			},
+		}
+
+		txBytes, err := tx.Marshal()
+		Expect(err).To(BeNil())
+
+		tracker.Record(reportCtx, &sdk.TxResponse{
+			TxHash:  "0708",
+			Height:  42,
+			GasUsed: 300000,
+			Tx:      &codectypes.Any{Value: txBytes},
+		}, time.Now())
+
+		// reported right away, Tendermint has no such Tx
+		var conf *TxConfirmation
+		Expect(confirmed).To(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationIncluded))
+		Expect(conf.Height).To(Equal(int64(42)))
+		Expect(conf.GasUsed).To(Equal(int64(300000)))
+		Expect(conf.NumMsgs).To(Equal(2))
+		Expect(conf.Fees.String()).To(Equal("1000inj"))
+	})
+
+	It("polls for a recorded Tx not included yet", func() {
+		hash := []byte{0x09}
+		addTx(hash, abci.ResponseDeliverTx{})
+
+		tracker.Record(reportCtx, &sdk.TxResponse{
+			TxHash: hex.EncodeToString(hash),
+		}, time.Now())
+
+		var conf *TxConfirmation
+		Eventually(confirmed).Should(Receive(&conf))
+		Expect(conf.Status).To(Equal(TxConfirmationIncluded))
+		Expect(conf.Height).To(Equal(int64(1)))
+	})
+
+	It("ignores Txs tracked after Close", func() {
+		Expect(tracker.Close()).To(BeNil())
+
+		tracker.Track(reportCtx, hex.EncodeToString([]byte{0x06}))
+		Consistently(confirmed, 300*time.Millisecond).ShouldNot(Receive())
+	})
+})
+
+func testConfigDigest(b byte) types.ConfigDigest {
+	var digest types.ConfigDigest
+	digest[1] = byte(ConfigDigestPrefixCosmos)
+	digest[31] = b
+
+	return digest
+}
+
+var _ tmclient.TendermintClient = &fakeTendermintClient{}
+
+type fakeTendermintClient struct {
+	blocks []*ctypes.ResultBlock
+	txs    map[int64][]*ctypes.ResultTx
+}
+
+func newFakeTendermintClient() *fakeTendermintClient {
+	return &fakeTendermintClient{
+		txs: make(map[int64][]*ctypes.ResultTx),
+	}
+}
+
+func (c *fakeTendermintClient) addBlock(blockTime time.Time, txs ...*ctypes.ResultTx) {
+	height := int64(len(c.blocks) + 1)
+
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{
+			Height: height,
+			Time:   blockTime,
+		},
+	}
+
+	for i := range txs {
+		txs[i].Height = height
+		block.Data.Txs = append(block.Data.Txs, tmtypes.Tx{byte(height), byte(i)})
+	}
+
+	c.blocks = append(c.blocks, &ctypes.ResultBlock{
+		Block: block,
+	})
+	c.txs[height] = txs
+}
+
+func (c *fakeTendermintClient) GetBlock(ctx context.Context, height int64) (*ctypes.ResultBlock, error) {
+	if height < 1 || height > int64(len(c.blocks)) {
+		return nil, errors.Errorf("block not found: %d", height)
+	}
+
+	return c.blocks[height-1], nil
+}
+
+func (c *fakeTendermintClient) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	return int64(len(c.blocks)), nil
+}
+
+func (c *fakeTendermintClient) GetTxs(ctx context.Context, block *ctypes.ResultBlock) ([]*ctypes.ResultTx, error) {
+	return c.txs[block.Block.Height], nil
+}
+
+func (c *fakeTendermintClient) GetTx(ctx context.Context, hash []byte) (*ctypes.ResultTx, error) {
+	for _, txs := range c.txs {
+		for _, tx := range txs {
+			if bytes.Equal(tx.Hash, hash) {
+				return tx, nil
+			}
+		}
+	}
+
+	return nil, tmclient.ErrTxNotFound
+}
+
+func (c *fakeTendermintClient) GetValidatorSet(ctx context.Context, height int64) (*ctypes.ResultValidators, error) {
+	return &ctypes.ResultValidators{}, nil
+}
+
+func (c *fakeTendermintClient) SubscribeEvents(ctx context.Context, subscriber, query string) (<-chan ctypes.ResultEvent, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeTendermintClient) UnsubscribeEvents(ctx context.Context, subscriber, query string) error {
+	return nil
+}
@@ -0,0 +1,40 @@
+package txbroadcaster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCosmosClientBroadcaster adapts CosmosClient to TxBroadcaster. The Txs are broadcasted once,
+// without simulation, fee bumps and retries.
+func NewCosmosClientBroadcaster(cosmosClient chainclient.CosmosClient) TxBroadcaster {
+	return &cosmosClientBroadcaster{
+		cosmosClient: cosmosClient,
+	}
+}
+
+type cosmosClientBroadcaster struct {
+	cosmosClient chainclient.CosmosClient
+}
+
+func (b *cosmosClientBroadcaster) FromAddress() sdk.AccAddress {
+	return b.cosmosClient.FromAddress()
+}
+
+func (b *cosmosClientBroadcaster) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txResp, err := b.cosmosClient.SyncBroadcastMsg(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if txResp.Code != 0 {
+		err = errors.Errorf("Tx failed: code %d (%s): %s", txResp.Code, txResp.Codespace, txResp.RawLog)
+		return txResp, err
+	}
+
+	return txResp, nil
+}
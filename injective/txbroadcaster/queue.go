@@ -0,0 +1,420 @@
+package txbroadcaster
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+// TxQueue is a node-wide TxBroadcaster that serializes broadcasts for the sender account
+// and batches msgs of concurrent callers into a single Tx.
+type TxQueue interface {
+	TxBroadcaster
+	Close()
+}
+
+type QueueConfig struct {
+	// MaxBatchSize limits the number of msgs in a single Tx, defaults to 1 (no batching).
+	MaxBatchSize int
+	// BatchWindow is the time to wait for more msgs when several callers are pending.
+	// A single pending caller is never delayed.
+	BatchWindow time.Duration
+}
+
+var ErrQueueClosed = errors.New("tx queue is closed")
+
+const (
+	// defaultBatchTimeout is used for batches where no caller set a deadline.
+	defaultBatchTimeout = time.Minute
+)
+
+// msgIndexRx matches the index of the failed msg in errors of Cosmos msg execution.
+var msgIndexRx = regexp.MustCompile(`message index: (\d+)`)
+
+type txQueue struct {
+	broadcaster TxBroadcaster
+	// async is set if the broadcaster can send Txs without awaiting them,
+	// so Txs are pipelined with sequences assigned locally.
+	async AsyncTxBroadcaster
+	cfg   QueueConfig
+
+	requestsC chan *txRequest
+	closeC    chan struct{}
+	onceStop  sync.Once
+	doneC     chan struct{}
+	wg        sync.WaitGroup
+
+	svcTags metrics.Tags
+	logger  log.Logger
+}
+
+type txRequest struct {
+	ctx   context.Context
+	msgs  []sdk.Msg
+	respC chan *txResult
+
+	// txHash of the Tx the msgs were sent in, while it's awaited
+	txHashMux sync.Mutex
+	txHash    string
+}
+
+type txResult struct {
+	txResp *sdk.TxResponse
+	err    error
+}
+
+func NewTxQueue(broadcaster TxBroadcaster, cfg QueueConfig) TxQueue {
+	if cfg.MaxBatchSize < 1 {
+		cfg.MaxBatchSize = 1
+	}
+
+	q := &txQueue{
+		broadcaster: broadcaster,
+		cfg:         cfg,
+
+		requestsC: make(chan *txRequest),
+		closeC:    make(chan struct{}),
+		doneC:     make(chan struct{}),
+
+		svcTags: metrics.Tags{
+			"svc": "tx_queue",
+		},
+		logger: log.WithFields(log.Fields{
+			"svc": "tx_queue",
+		}),
+	}
+
+	if async, ok := broadcaster.(AsyncTxBroadcaster); ok {
+		q.async = async
+	}
+
+	go q.run()
+
+	return q
+}
+
+func (q *txQueue) FromAddress() sdk.AccAddress {
+	return q.broadcaster.FromAddress()
+}
+
+// BroadcastMsgs queues the msgs and waits for the result of the Tx they were included in.
+// If the batched Tx fails because of a single msg, the other callers' msgs are sent again,
+// so one bad msg doesn't fail the others. If ctx is done after the Tx has been sent,
+// the error cause is ErrTxUnconfirmed.
+func (q *txQueue) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	req := &txRequest{
+		ctx:   ctx,
+		msgs:  msgs,
+		respC: make(chan *txResult, 1),
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-q.closeC:
+		return nil, ErrQueueClosed
+	case q.requestsC <- req:
+	}
+
+	select {
+	case <-ctx.Done():
+		if txHash := req.sentTxHash(); len(txHash) > 0 {
+			err := errors.Wrapf(ErrTxUnconfirmed, "Tx %s (%v)", txHash, ctx.Err())
+			return &sdk.TxResponse{TxHash: txHash}, err
+		}
+
+		return nil, ctx.Err()
+	case res := <-req.respC:
+		return res.txResp, res.err
+	}
+}
+
+// Close stops the queue after the current batch is sent, queued callers get ErrQueueClosed.
+// Callers awaiting the sent Txs get ErrTxUnconfirmed.
+func (q *txQueue) Close() {
+	q.onceStop.Do(func() {
+		close(q.closeC)
+	})
+
+	<-q.doneC
+	q.wg.Wait()
+}
+
+func (q *txQueue) run() {
+	defer close(q.doneC)
+
+	for {
+		var batch []*txRequest
+
+		select {
+		case <-q.closeC:
+			return
+		case req := <-q.requestsC:
+			batch = append(batch, req)
+		}
+
+		if !q.collect(&batch) {
+			respondAll(batch, nil, ErrQueueClosed)
+			return
+		}
+
+		q.sendBatch(batch)
+	}
+}
+
+// collect adds requests that are already pending to the batch. If there are several of them,
+// it waits for more until the batch is full or the batch window passes.
+// Returns false if the queue has been closed meanwhile.
+func (q *txQueue) collect(batch *[]*txRequest) bool {
+drain:
+	for numMsgs(*batch) < q.cfg.MaxBatchSize {
+		select {
+		case <-q.closeC:
+			return false
+		case req := <-q.requestsC:
+			*batch = append(*batch, req)
+		default:
+			break drain
+		}
+	}
+
+	if len(*batch) == 1 || q.cfg.BatchWindow == 0 {
+		return true
+	}
+
+	window := time.NewTimer(q.cfg.BatchWindow)
+	defer window.Stop()
+
+	for numMsgs(*batch) < q.cfg.MaxBatchSize {
+		select {
+		case <-q.closeC:
+			return false
+		case <-window.C:
+			return true
+		case req := <-q.requestsC:
+			*batch = append(*batch, req)
+		}
+	}
+
+	return true
+}
+
+// sendBatch sends the batch in a single Tx and awaits its inclusion in background.
+// If the Tx is rejected because of a single msg, its caller gets the error and
+// the rest of the batch is sent again.
+func (q *txQueue) sendBatch(batch []*txRequest) {
+	for {
+		// drop requests of callers that are gone
+		pending := batch[:0]
+		for _, req := range batch {
+			if err := req.ctx.Err(); err != nil {
+				req.respC <- &txResult{err: err}
+				continue
+			}
+
+			pending = append(pending, req)
+		}
+
+		if len(pending) == 0 {
+			return
+		}
+
+		msgs := make([]sdk.Msg, 0, numMsgs(pending))
+		for _, req := range pending {
+			msgs = append(msgs, req.msgs...)
+		}
+
+		if len(pending) > 1 {
+			metrics.ReportTxBatchSize(len(msgs), q.svcTags)
+		}
+
+		ctx, cancelFn := batchContext(pending)
+		txResp, err := q.sendMsgs(ctx, msgs)
+		if err == nil {
+			q.awaitBatch(ctx, cancelFn, pending, txResp)
+			return
+		}
+
+		cancelFn()
+
+		if len(pending) == 1 || ctx.Err() != nil {
+			respondAll(pending, txResp, err)
+			return
+		}
+
+		failed, rest := splitFailedRequest(pending, err)
+		if failed == nil {
+			q.sendSeparately(pending, err)
+			return
+		}
+
+		metrics.ReportTxBatchFallback(q.svcTags)
+		q.logger.WithError(err).WithField("msgs", len(msgs)).Warningln("batched Tx rejected, sending without the failed msg")
+
+		failed.respC <- &txResult{txResp: txResp, err: err}
+		batch = rest
+	}
+}
+
+// sendSeparately sends msgs of each request in its own Tx, when the failed msg of the batch is unknown.
+func (q *txQueue) sendSeparately(batch []*txRequest, batchErr error) {
+	metrics.ReportTxBatchFallback(q.svcTags)
+	q.logger.WithError(batchErr).WithField("msgs", numMsgs(batch)).Warningln("batched Tx rejected, sending msgs separately")
+
+	for _, req := range batch {
+		q.sendBatch([]*txRequest{req})
+	}
+}
+
+// awaitBatch waits for the Tx inclusion in background and responds to the batched callers.
+// If the Tx failed in DeliverTx because of a single msg, the rest of the batch is queued again.
+func (q *txQueue) awaitBatch(ctx context.Context, cancelFn context.CancelFunc, batch []*txRequest, txResp *sdk.TxResponse) {
+	for _, req := range batch {
+		req.setSentTxHash(txResp.TxHash)
+	}
+
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		defer cancelFn()
+
+		go func() {
+			select {
+			case <-q.closeC:
+				cancelFn()
+			case <-ctx.Done():
+			}
+		}()
+
+		txResp, err := q.awaitTx(ctx, txResp)
+		if err == nil || len(batch) == 1 || errors.Cause(err) == ErrTxUnconfirmed {
+			respondAll(batch, txResp, err)
+			return
+		}
+
+		failed, rest := splitFailedRequest(batch, err)
+		if failed == nil {
+			respondAll(batch, txResp, err)
+			return
+		}
+
+		metrics.ReportTxBatchFallback(q.svcTags)
+		q.logger.WithError(err).WithField("txHash", txResp.TxHash).Warningln("batched Tx failed, queueing msgs without the failed one")
+
+		failed.respC <- &txResult{txResp: txResp, err: err}
+		for _, req := range rest {
+			q.requeue(req)
+		}
+	}()
+}
+
+// requeue puts the request back to the queue, unless its caller is gone.
+func (q *txQueue) requeue(req *txRequest) {
+	req.setSentTxHash("")
+
+	select {
+	case <-req.ctx.Done():
+		req.respC <- &txResult{err: req.ctx.Err()}
+	case <-q.closeC:
+		req.respC <- &txResult{err: ErrQueueClosed}
+	case q.requestsC <- req:
+	}
+}
+
+func (q *txQueue) sendMsgs(ctx context.Context, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	if q.async != nil {
+		return q.async.SendMsgs(ctx, msgs...)
+	}
+
+	return q.broadcaster.BroadcastMsgs(ctx, msgs...)
+}
+
+func (q *txQueue) awaitTx(ctx context.Context, txResp *sdk.TxResponse) (*sdk.TxResponse, error) {
+	if q.async != nil {
+		return q.async.AwaitTx(ctx, txResp.TxHash)
+	}
+
+	// BroadcastMsgs returns after the inclusion
+	return txResp, nil
+}
+
+func (r *txRequest) setSentTxHash(txHash string) {
+	r.txHashMux.Lock()
+	r.txHash = txHash
+	r.txHashMux.Unlock()
+}
+
+func (r *txRequest) sentTxHash() string {
+	r.txHashMux.Lock()
+	defer r.txHashMux.Unlock()
+
+	return r.txHash
+}
+
+// splitFailedRequest finds the request with the msg that failed the batched Tx,
+// using the msg index reported by Cosmos. Returns nil if the index is unknown.
+func splitFailedRequest(batch []*txRequest, err error) (failed *txRequest, rest []*txRequest) {
+	match := msgIndexRx.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil, batch
+	}
+
+	msgIdx, parseErr := strconv.Atoi(match[1])
+	if parseErr != nil {
+		return nil, batch
+	}
+
+	rest = make([]*txRequest, 0, len(batch)-1)
+	for _, req := range batch {
+		if failed == nil && msgIdx < len(req.msgs) {
+			failed = req
+			continue
+		}
+
+		msgIdx -= len(req.msgs)
+		rest = append(rest, req)
+	}
+
+	return failed, rest
+}
+
+// batchContext returns a context with the latest deadline of the batched requests,
+// so the Tx isn't cancelled when one of the callers gives up.
+func batchContext(batch []*txRequest) (context.Context, context.CancelFunc) {
+	var deadline time.Time
+	for _, req := range batch {
+		reqDeadline, ok := req.ctx.Deadline()
+		if !ok {
+			return context.WithTimeout(context.Background(), defaultBatchTimeout)
+		}
+
+		if reqDeadline.After(deadline) {
+			deadline = reqDeadline
+		}
+	}
+
+	return context.WithDeadline(context.Background(), deadline)
+}
+
+func respondAll(batch []*txRequest, txResp *sdk.TxResponse, err error) {
+	for _, req := range batch {
+		req.respC <- &txResult{txResp: txResp, err: err}
+	}
+}
+
+func numMsgs(batch []*txRequest) (n int) {
+	for _, req := range batch {
+		n += len(req.msgs)
+	}
+
+	return n
+}
@@ -0,0 +1,267 @@
+package txbroadcaster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("TxQueue", func() {
+	var (
+		broadcaster *fakeAsyncBroadcaster
+		q           *txQueue
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		broadcaster = newFakeAsyncBroadcaster()
+		q = NewTxQueue(broadcaster, QueueConfig{
+			MaxBatchSize: 10,
+			BatchWindow:  10 * time.Millisecond,
+		}).(*txQueue)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		broadcaster.release()
+		q.Close()
+	})
+
+	newRequest := func(msgs ...sdk.Msg) *txRequest {
+		return &txRequest{
+			ctx:   ctx,
+			msgs:  msgs,
+			respC: make(chan *txResult, 1),
+		}
+	}
+
+	receive := func(req *txRequest) *txResult {
+		var res *txResult
+		Eventually(req.respC).Should(Receive(&res))
+
+		return res
+	}
+
+	It("sends a single pending request right away", func() {
+		broadcaster.release()
+
+		slowQueue := NewTxQueue(broadcaster, QueueConfig{
+			MaxBatchSize: 10,
+			BatchWindow:  time.Minute,
+		})
+		defer slowQueue.Close()
+
+		ts := time.Now()
+		txResp, err := slowQueue.BroadcastMsgs(ctx, testMsg(1))
+		Expect(err).To(BeNil())
+		Expect(txResp.Height).To(Equal(int64(10)))
+		Expect(time.Since(ts)).To(BeNumerically("<", time.Second))
+	})
+
+	It("sends the next Tx without waiting for inclusion of the previous one", func() {
+		results := make(chan error, 2)
+		for i := int64(1); i <= 2; i++ {
+			msg := testMsg(i)
+			go func() {
+				_, err := q.BroadcastMsgs(ctx, msg)
+				results <- err
+			}()
+
+			Eventually(broadcaster.sentTxs).Should(HaveLen(int(i)))
+		}
+
+		Consistently(results, 100*time.Millisecond).ShouldNot(Receive())
+
+		broadcaster.release()
+		Eventually(results).Should(Receive(BeNil()))
+		Eventually(results).Should(Receive(BeNil()))
+	})
+
+	It("reports a sent Tx not included before ctx is done as unconfirmed", func() {
+		timeoutCtx, cancelFn := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancelFn()
+
+		txResp, err := q.BroadcastMsgs(timeoutCtx, testMsg(1))
+		Expect(errors.Cause(err)).To(Equal(ErrTxUnconfirmed))
+		Expect(txResp.TxHash).To(Equal("TX1"))
+	})
+
+	It("drops the msg that failed CheckTx and sends the rest of the batch", func() {
+		broadcaster.release()
+
+		stale := testMsg(2)
+		broadcaster.failCheckTx(stale)
+
+		reqs := []*txRequest{newRequest(testMsg(1)), newRequest(stale), newRequest(testMsg(3))}
+		q.sendBatch(reqs)
+
+		Expect(receive(reqs[1]).err).ToNot(BeNil())
+
+		for _, req := range []*txRequest{reqs[0], reqs[2]} {
+			res := receive(req)
+			Expect(res.err).To(BeNil())
+			Expect(res.txResp.TxHash).To(Equal("TX2"))
+		}
+
+		Expect(broadcaster.sentTxs()).To(Equal([]int{3, 2}))
+	})
+
+	It("queues the rest of the batch again when a msg fails in DeliverTx", func() {
+		broadcaster.release()
+
+		stale := testMsg(2)
+		broadcaster.failDeliverTx(stale)
+
+		reqs := []*txRequest{newRequest(testMsg(1)), newRequest(stale), newRequest(testMsg(3))}
+		q.sendBatch(reqs)
+
+		res := receive(reqs[1])
+		Expect(res.err).ToNot(BeNil())
+		Expect(res.txResp.Code).To(Equal(uint32(5)))
+
+		for _, req := range []*txRequest{reqs[0], reqs[2]} {
+			res := receive(req)
+			Expect(res.err).To(BeNil())
+			Expect(res.txResp.TxHash).ToNot(Equal("TX1"))
+		}
+	})
+
+	It("sends msgs separately when the failed msg of the batch is unknown", func() {
+		broadcaster.release()
+		broadcaster.rejectAllBatches()
+
+		reqs := []*txRequest{newRequest(testMsg(1)), newRequest(testMsg(2))}
+		q.sendBatch(reqs)
+
+		for _, req := range reqs {
+			Expect(receive(req).err).To(BeNil())
+		}
+
+		Expect(broadcaster.sentTxs()).To(Equal([]int{2, 1, 1}))
+	})
+})
+
+func testMsg(amount int64) sdk.Msg {
+	addr := sdk.AccAddress("sender______________")
+	return banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("inj", amount)))
+}
+
+// fakeAsyncBroadcaster accepts all Txs, unless they contain failing msgs. Accepted Txs
+// are included once released, until then AwaitTx blocks.
+type fakeAsyncBroadcaster struct {
+	mux           sync.Mutex
+	txs           map[string][]sdk.Msg
+	sent          []int
+	failCheck     map[sdk.Msg]bool
+	failDeliver   map[sdk.Msg]bool
+	rejectBatches bool
+
+	releaseOnce sync.Once
+	releaseC    chan struct{}
+}
+
+func newFakeAsyncBroadcaster() *fakeAsyncBroadcaster {
+	return &fakeAsyncBroadcaster{
+		txs:         make(map[string][]sdk.Msg),
+		failCheck:   make(map[sdk.Msg]bool),
+		failDeliver: make(map[sdk.Msg]bool),
+		releaseC:    make(chan struct{}),
+	}
+}
+
+func (b *fakeAsyncBroadcaster) FromAddress() sdk.AccAddress {
+	return sdk.AccAddress("sender______________")
+}
+
+func (b *fakeAsyncBroadcaster) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txResp, err := b.SendMsgs(ctx, msgs...)
+	if err != nil {
+		return txResp, err
+	}
+
+	return b.AwaitTx(ctx, txResp.TxHash)
+}
+
+func (b *fakeAsyncBroadcaster) SendMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.sent = append(b.sent, len(msgs))
+
+	if b.rejectBatches && len(msgs) > 1 {
+		return nil, errors.New("failed to simulate Tx: out of gas")
+	}
+
+	for idx, msg := range msgs {
+		if b.failCheck[msg] {
+			err := errors.Errorf("failed to simulate Tx: failed to execute message; message index: %d: stale report", idx)
+			return nil, err
+		}
+	}
+
+	txHash := fmt.Sprintf("TX%d", len(b.sent))
+	b.txs[txHash] = msgs
+
+	return &sdk.TxResponse{TxHash: txHash}, nil
+}
+
+func (b *fakeAsyncBroadcaster) AwaitTx(ctx context.Context, txHash string) (*sdk.TxResponse, error) {
+	select {
+	case <-ctx.Done():
+		err := errors.Wrapf(ErrTxUnconfirmed, "Tx %s (%v)", txHash, ctx.Err())
+		return &sdk.TxResponse{TxHash: txHash}, err
+	case <-b.releaseC:
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	for idx, msg := range b.txs[txHash] {
+		if b.failDeliver[msg] {
+			err := errors.Errorf("Tx failed in DeliverTx: code 5: failed to execute message; message index: %d: stale report", idx)
+			return &sdk.TxResponse{TxHash: txHash, Height: 10, Code: 5}, err
+		}
+	}
+
+	return &sdk.TxResponse{TxHash: txHash, Height: 10}, nil
+}
+
+func (b *fakeAsyncBroadcaster) failCheckTx(msg sdk.Msg) {
+	b.mux.Lock()
+	b.failCheck[msg] = true
+	b.mux.Unlock()
+}
+
+func (b *fakeAsyncBroadcaster) rejectAllBatches() {
+	b.mux.Lock()
+	b.rejectBatches = true
+	b.mux.Unlock()
+}
+
+func (b *fakeAsyncBroadcaster) failDeliverTx(msg sdk.Msg) {
+	b.mux.Lock()
+	b.failDeliver[msg] = true
+	b.mux.Unlock()
+}
+
+// release lets all sent Txs be included.
+func (b *fakeAsyncBroadcaster) release() {
+	b.releaseOnce.Do(func() {
+		close(b.releaseC)
+	})
+}
+
+// sentTxs returns the number of msgs in each sent Tx.
+func (b *fakeAsyncBroadcaster) sentTxs() []int {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	return append([]int{}, b.sent...)
+}
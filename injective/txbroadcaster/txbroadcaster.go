@@ -0,0 +1,522 @@
+package txbroadcaster
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+// TxBroadcaster signs and broadcasts Cosmos Txs on behalf of a single account,
+// taking care of gas estimation, fee bumps and the account sequence.
+type TxBroadcaster interface {
+	// BroadcastMsgs wraps msgs into a single Tx and broadcasts it, retrying on transient
+	// chain conditions until the Tx is included in a block or ctx is done. If the Tx is
+	// in mempool, but not included before ctx is done, the error cause is ErrTxUnconfirmed
+	// and the returned TxResponse has the TxHash set.
+	BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+	FromAddress() sdk.AccAddress
+}
+
+// AsyncTxBroadcaster is a TxBroadcaster that can also send a Tx without waiting for its
+// inclusion, so the next Tx can be sent with the next sequence meanwhile.
+type AsyncTxBroadcaster interface {
+	TxBroadcaster
+
+	// SendMsgs wraps msgs into a single Tx and broadcasts it, retrying on transient
+	// chain conditions until the Tx passes CheckTx or ctx is done.
+	SendMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+	// AwaitTx waits for the Tx inclusion and returns its DeliverTx result. If ctx is done
+	// before that, the error cause is ErrTxUnconfirmed.
+	AwaitTx(ctx context.Context, txHash string) (*sdk.TxResponse, error)
+}
+
+type Config struct {
+	// GasPrices are used to compute fees for the first broadcast attempt.
+	GasPrices sdk.DecCoins
+	// MaxGasPrices is the ceiling for fee bumps, defaults to GasPrices (no bumps).
+	MaxGasPrices sdk.DecCoins
+	// FeeBumpFactor multiplies gas prices after each fee-related rejection.
+	FeeBumpFactor float64
+	// GasAdjustment multiplies the simulated gas to get the Tx gas limit.
+	GasAdjustment float64
+	// RetryInterval is the delay between broadcast attempts.
+	RetryInterval time.Duration
+	// InclusionPollInterval is the interval of polling for the Tx inclusion.
+	InclusionPollInterval time.Duration
+}
+
+// ErrTxUnconfirmed means the Tx has been accepted to mempool, but its inclusion
+// is not known yet. It's not a failure, the Tx may still get included.
+var ErrTxUnconfirmed = errors.New("Tx not included in a block yet")
+
+const (
+	defaultFeeBumpFactor         = 1.25
+	defaultGasAdjustment         = 1.5
+	defaultRetryInterval         = 500 * time.Millisecond
+	defaultInclusionPollInterval = time.Second
+
+	// outOfGasAdjustmentFactor increases the gas adjustment of a Tx that ran out of gas.
+	outOfGasAdjustmentFactor = 1.2
+)
+
+type txBroadcaster struct {
+	clientCtx client.Context
+	txClient  txtypes.ServiceClient
+	cfg       Config
+
+	pubKey        cryptotypes.PubKey
+	signMode      signing.SignMode
+	feeBumpFactor sdk.Dec
+
+	accMux    *sync.Mutex
+	accNum    uint64
+	accSeq    uint64
+	seqSynced bool
+
+	svcTags metrics.Tags
+	logger  log.Logger
+}
+
+// NewTxBroadcaster inits a TxBroadcaster for the sender account of clientCtx. The clientCtx
+// must have Keyring, TxConfig, AccountRetriever and RPC Client set, conn is used for
+// the Tx service of the Cosmos daemon.
+func NewTxBroadcaster(
+	clientCtx client.Context,
+	conn *grpc.ClientConn,
+	cfg Config,
+) (AsyncTxBroadcaster, error) {
+	return newTxBroadcaster(clientCtx, txtypes.NewServiceClient(conn), cfg)
+}
+
+func newTxBroadcaster(
+	clientCtx client.Context,
+	txClient txtypes.ServiceClient,
+	cfg Config,
+) (*txBroadcaster, error) {
+	if clientCtx.Keyring == nil {
+		err := errors.New("cannot init TxBroadcaster: no Keyring in client context")
+		return nil, err
+	} else if clientCtx.TxConfig == nil {
+		err := errors.New("cannot init TxBroadcaster: no TxConfig in client context")
+		return nil, err
+	}
+
+	keyInfo, err := clientCtx.Keyring.KeyByAddress(clientCtx.GetFromAddress())
+	if err != nil {
+		err = errors.Wrapf(err, "failed to find key for %s in keyring", clientCtx.GetFromAddress())
+		return nil, err
+	}
+
+	if len(cfg.GasPrices) == 0 {
+		err := errors.New("cannot init TxBroadcaster: gas prices must be set")
+		return nil, err
+	}
+
+	if len(cfg.MaxGasPrices) == 0 {
+		cfg.MaxGasPrices = cfg.GasPrices
+	}
+
+	if cfg.FeeBumpFactor <= 1 {
+		cfg.FeeBumpFactor = defaultFeeBumpFactor
+	}
+
+	if cfg.GasAdjustment <= 0 {
+		cfg.GasAdjustment = defaultGasAdjustment
+	}
+
+	if cfg.RetryInterval == 0 {
+		cfg.RetryInterval = defaultRetryInterval
+	}
+
+	if cfg.InclusionPollInterval == 0 {
+		cfg.InclusionPollInterval = defaultInclusionPollInterval
+	}
+
+	b := &txBroadcaster{
+		clientCtx: clientCtx,
+		txClient:  txClient,
+		cfg:       cfg,
+
+		pubKey:        keyInfo.GetPubKey(),
+		signMode:      clientCtx.TxConfig.SignModeHandler().DefaultMode(),
+		feeBumpFactor: sdk.MustNewDecFromStr(strconv.FormatFloat(cfg.FeeBumpFactor, 'f', 6, 64)),
+
+		accMux: new(sync.Mutex),
+
+		svcTags: metrics.Tags{
+			"svc": "tx_broadcaster",
+		},
+		logger: log.WithFields(log.Fields{
+			"svc":    "tx_broadcaster",
+			"sender": clientCtx.GetFromAddress().String(),
+		}),
+	}
+
+	return b, nil
+}
+
+func (b *txBroadcaster) FromAddress() sdk.AccAddress {
+	return b.clientCtx.GetFromAddress()
+}
+
+// retryReason classifies a failed attempt, empty reason means it's final.
+type retryReason string
+
+const (
+	retrySequenceMismatch retryReason = "sequence_mismatch"
+	retryInsufficientFee  retryReason = "insufficient_fee"
+	retryMempoolFull      retryReason = "mempool_full"
+	retryOutOfGas         retryReason = "out_of_gas"
+	retryUnavailable      retryReason = "unavailable"
+)
+
+func (b *txBroadcaster) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	metrics.ReportFuncCall(b.svcTags)
+	doneFn := metrics.ReportFuncTiming(b.svcTags)
+	defer doneFn()
+
+	gasAdjustment := b.cfg.GasAdjustment
+
+	for attempt := 1; ; attempt++ {
+		txResp, err := b.sendMsgs(ctx, msgs, gasAdjustment)
+		if err != nil {
+			metrics.ReportFuncError(b.svcTags)
+			return txResp, err
+		}
+
+		// the Tx is in mempool, sequence is consumed
+		txResp, reason, err := b.awaitTx(ctx, txResp.TxHash)
+		if err == nil {
+			return txResp, nil
+		} else if reason != retryOutOfGas {
+			if errors.Cause(err) != ErrTxUnconfirmed {
+				metrics.ReportFuncError(b.svcTags)
+			}
+
+			return txResp, err
+		}
+
+		metrics.ReportTxRetried(string(reason), b.svcTags)
+
+		b.logger.WithFields(log.Fields{
+			"attempt": attempt,
+			"reason":  reason,
+		}).WithError(err).Warningln("retrying Tx broadcast")
+
+		gasAdjustment *= outOfGasAdjustmentFactor
+	}
+}
+
+func (b *txBroadcaster) SendMsgs(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	metrics.ReportFuncCall(b.svcTags)
+	doneFn := metrics.ReportFuncTiming(b.svcTags)
+	defer doneFn()
+
+	txResp, err := b.sendMsgs(ctx, msgs, b.cfg.GasAdjustment)
+	if err != nil {
+		metrics.ReportFuncError(b.svcTags)
+		return txResp, err
+	}
+
+	return txResp, nil
+}
+
+func (b *txBroadcaster) AwaitTx(ctx context.Context, txHash string) (*sdk.TxResponse, error) {
+	txResp, _, err := b.awaitTx(ctx, txHash)
+	return txResp, err
+}
+
+// sendMsgs retries broadcasting the Tx on transient conditions, until it passes CheckTx or ctx is done.
+func (b *txBroadcaster) sendMsgs(ctx context.Context, msgs []sdk.Msg, gasAdjustment float64) (*sdk.TxResponse, error) {
+	gasPrices := b.cfg.GasPrices
+
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				err := errors.Wrapf(lastErr, "Tx not broadcasted after %d attempts", attempt-1)
+				return nil, err
+			case <-time.After(b.cfg.RetryInterval):
+			}
+		}
+
+		txResp, reason, err := b.broadcastTx(ctx, msgs, gasPrices, gasAdjustment)
+		if err == nil {
+			return txResp, nil
+		} else if len(reason) == 0 {
+			return txResp, err
+		}
+
+		lastErr = err
+		metrics.ReportTxRetried(string(reason), b.svcTags)
+
+		b.logger.WithFields(log.Fields{
+			"attempt": attempt,
+			"reason":  reason,
+		}).WithError(err).Warningln("retrying Tx broadcast")
+
+		switch reason {
+		case retryInsufficientFee, retryMempoolFull:
+			bumped, ok := b.bumpGasPrices(gasPrices)
+			if ok {
+				gasPrices = bumped
+				metrics.ReportTxFeeBumped(b.svcTags)
+			} else if reason == retryInsufficientFee {
+				err = errors.Wrapf(err, "gas prices already at the ceiling %s", b.cfg.MaxGasPrices)
+				return txResp, err
+			}
+		case retryOutOfGas:
+			gasAdjustment *= outOfGasAdjustmentFactor
+		}
+	}
+}
+
+// broadcastTx simulates, signs and broadcasts a Tx in sync mode, so only CheckTx result is returned.
+// The account lock is held for the attempt, so Txs of concurrent callers get sequential nonces.
+func (b *txBroadcaster) broadcastTx(
+	ctx context.Context,
+	msgs []sdk.Msg,
+	gasPrices sdk.DecCoins,
+	gasAdjustment float64,
+) (*sdk.TxResponse, retryReason, error) {
+	b.accMux.Lock()
+	defer b.accMux.Unlock()
+
+	if !b.seqSynced {
+		if err := b.syncSequence(); err != nil {
+			return nil, retryUnavailable, err
+		}
+	}
+
+	simTxBytes, err := b.buildTx(msgs, 0, nil, false)
+	if err != nil {
+		err = errors.Wrap(err, "failed to build Tx for simulation")
+		return nil, "", err
+	}
+
+	simResp, err := b.txClient.Simulate(ctx, &txtypes.SimulateRequest{
+		TxBytes: simTxBytes,
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to simulate Tx")
+
+		if strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error()) {
+			b.seqSynced = false
+			return nil, retrySequenceMismatch, err
+		} else if isUnavailable(err) {
+			return nil, retryUnavailable, err
+		}
+
+		return nil, "", err
+	}
+
+	metrics.ReportTxSimulatedGas(simResp.GasInfo.GasUsed, b.svcTags)
+
+	gasLimit := uint64(gasAdjustment * float64(simResp.GasInfo.GasUsed))
+	txBytes, err := b.buildTx(msgs, gasLimit, feeForGas(gasPrices, gasLimit), true)
+	if err != nil {
+		err = errors.Wrap(err, "failed to build and sign Tx")
+		return nil, "", err
+	}
+
+	resp, err := b.txClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to broadcast Tx")
+
+		if isUnavailable(err) {
+			return nil, retryUnavailable, err
+		}
+
+		return nil, "", err
+	}
+
+	txResp := resp.TxResponse
+
+	if txResp.Code == 0 || isTxError(txResp, sdkerrors.ErrTxInMempoolCache) {
+		b.accSeq++
+		return txResp, "", nil
+	}
+
+	err = errors.Errorf("Tx rejected by CheckTx: code %d (%s): %s", txResp.Code, txResp.Codespace, txResp.RawLog)
+
+	switch {
+	case isTxError(txResp, sdkerrors.ErrWrongSequence):
+		b.seqSynced = false
+		return txResp, retrySequenceMismatch, err
+	case isTxError(txResp, sdkerrors.ErrInsufficientFee):
+		return txResp, retryInsufficientFee, err
+	case isTxError(txResp, sdkerrors.ErrMempoolIsFull):
+		return txResp, retryMempoolFull, err
+	case isTxError(txResp, sdkerrors.ErrOutOfGas):
+		return txResp, retryOutOfGas, err
+	}
+
+	return txResp, "", err
+}
+
+// awaitTx polls for the Tx inclusion and checks its DeliverTx result.
+func (b *txBroadcaster) awaitTx(ctx context.Context, txHash string) (*sdk.TxResponse, retryReason, error) {
+	t := time.NewTicker(b.cfg.InclusionPollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			err := errors.Wrapf(ErrTxUnconfirmed, "Tx %s (%v)", txHash, ctx.Err())
+			return &sdk.TxResponse{TxHash: txHash}, "", err
+		case <-t.C:
+		}
+
+		resp, err := b.txClient.GetTx(ctx, &txtypes.GetTxRequest{
+			Hash: txHash,
+		})
+		if err != nil {
+			if status.Code(err) != codes.NotFound && !isUnavailable(err) {
+				b.logger.WithError(err).Debugln("failed to query Tx", txHash)
+			}
+
+			continue
+		}
+
+		txResp := resp.TxResponse
+		if txResp.Code == 0 {
+			return txResp, "", nil
+		}
+
+		err = errors.Errorf("Tx failed in DeliverTx: code %d (%s): %s", txResp.Code, txResp.Codespace, txResp.RawLog)
+		if isTxError(txResp, sdkerrors.ErrOutOfGas) {
+			return txResp, retryOutOfGas, err
+		}
+
+		return txResp, "", err
+	}
+}
+
+func (b *txBroadcaster) syncSequence() error {
+	accNum, accSeq, err := b.clientCtx.AccountRetriever.GetAccountNumberSequence(b.clientCtx, b.FromAddress())
+	if err != nil {
+		err = errors.Wrap(err, "failed to get account number and sequence")
+		return err
+	}
+
+	if b.accSeq > 0 {
+		metrics.ReportTxSequenceResynced(b.svcTags)
+		b.logger.WithFields(log.Fields{
+			"local":   b.accSeq,
+			"onchain": accSeq,
+		}).Infoln("resynced account sequence")
+	}
+
+	b.accNum = accNum
+	b.accSeq = accSeq
+	b.seqSynced = true
+
+	return nil
+}
+
+// buildTx builds the Tx with current account sequence. Unsigned Txs get an empty signature
+// with the account pubkey, which is enough for the simulation.
+func (b *txBroadcaster) buildTx(msgs []sdk.Msg, gasLimit uint64, fees sdk.Coins, sign bool) ([]byte, error) {
+	txBuilder := b.clientCtx.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+
+	txBuilder.SetGasLimit(gasLimit)
+	txBuilder.SetFeeAmount(fees)
+
+	if !sign {
+		sig := signing.SignatureV2{
+			PubKey: b.pubKey,
+			Data: &signing.SingleSignatureData{
+				SignMode: b.signMode,
+			},
+			Sequence: b.accSeq,
+		}
+
+		if err := txBuilder.SetSignatures(sig); err != nil {
+			return nil, err
+		}
+	} else {
+		txf := clienttx.Factory{}.
+			WithChainID(b.clientCtx.ChainID).
+			WithTxConfig(b.clientCtx.TxConfig).
+			WithKeybase(b.clientCtx.Keyring).
+			WithAccountNumber(b.accNum).
+			WithSequence(b.accSeq).
+			WithSignMode(b.signMode)
+
+		if err := clienttx.Sign(txf, b.clientCtx.GetFromName(), txBuilder, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// bumpGasPrices multiplies gas prices by the bump factor, capped by MaxGasPrices.
+// Prices of denoms missing in MaxGasPrices have no ceiling set, so they're not bumped.
+// Returns false if all prices are already at the ceiling.
+func (b *txBroadcaster) bumpGasPrices(gasPrices sdk.DecCoins) (sdk.DecCoins, bool) {
+	bumped := make(sdk.DecCoins, 0, len(gasPrices))
+	anyBumped := false
+
+	for _, price := range gasPrices {
+		amount := price.Amount.Mul(b.feeBumpFactor)
+		if ceiling := b.cfg.MaxGasPrices.AmountOf(price.Denom); amount.GT(ceiling) {
+			amount = sdk.MaxDec(ceiling, price.Amount)
+		}
+
+		if amount.GT(price.Amount) {
+			anyBumped = true
+		}
+
+		bumped = append(bumped, sdk.NewDecCoinFromDec(price.Denom, amount))
+	}
+
+	return bumped, anyBumped
+}
+
+func feeForGas(gasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit))
+	fees := make(sdk.Coins, 0, len(gasPrices))
+
+	for _, price := range gasPrices {
+		fee := price.Amount.Mul(gas).Ceil().RoundInt()
+		fees = append(fees, sdk.NewCoin(price.Denom, fee))
+	}
+
+	return fees.Sort()
+}
+
+func isTxError(txResp *sdk.TxResponse, sdkErr *sdkerrors.Error) bool {
+	return txResp.Codespace == sdkErr.Codespace() && txResp.Code == sdkErr.ABCICode()
+}
+
+func isUnavailable(err error) bool {
+	code := status.Code(errors.Cause(err))
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
@@ -0,0 +1,18 @@
+package txbroadcaster
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/xlab/suplog"
+)
+
+func TestTxBroadcaster(t *testing.T) {
+	if !testing.Verbose() {
+		log.DefaultLogger.SetLevel(log.FatalLevel)
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tx Broadcaster Test Suite")
+}
@@ -0,0 +1,266 @@
+package txbroadcaster
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cosmcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+	"github.com/InjectiveLabs/sdk-go/chain/crypto/ethsecp256k1"
+	"github.com/InjectiveLabs/sdk-go/chain/crypto/hd"
+)
+
+var _ = Describe("TxBroadcaster", func() {
+	var (
+		txClient  *fakeTxClient
+		accounts  *fakeAccountRetriever
+		clientCtx client.Context
+		cfg       Config
+		ctx       context.Context
+		msg       sdk.Msg
+	)
+
+	BeforeEach(func() {
+		txClient = newFakeTxClient()
+		accounts = &fakeAccountRetriever{accNum: 7, accSeq: 10}
+		clientCtx = newTestClientContext().WithAccountRetriever(accounts)
+		cfg = Config{
+			GasPrices:             sdk.NewDecCoins(sdk.NewInt64DecCoin("inj", 500000000)),
+			RetryInterval:         time.Millisecond,
+			InclusionPollInterval: time.Millisecond,
+		}
+		ctx = context.Background()
+		msg = banktypes.NewMsgSend(clientCtx.GetFromAddress(), clientCtx.GetFromAddress(), sdk.NewCoins(sdk.NewInt64Coin("inj", 1)))
+	})
+
+	newBroadcaster := func() *txBroadcaster {
+		b, err := newTxBroadcaster(clientCtx, txClient, cfg)
+		Expect(err).To(BeNil())
+
+		return b
+	}
+
+	It("returns the DeliverTx result of the included Tx", func() {
+		txResp, err := newBroadcaster().BroadcastMsgs(ctx, msg)
+		Expect(err).To(BeNil())
+		Expect(txResp.Height).To(Equal(int64(100)))
+
+		// gas limit is the simulated gas multiplied by the default adjustment
+		fees := txClient.broadcastedFees(clientCtx)
+		Expect(fees).To(HaveLen(1))
+		Expect(fees[0].String()).To(Equal("75000000000000inj"))
+	})
+
+	It("resyncs the account sequence on mismatch and retries", func() {
+		txClient.checkTxResults = []*sdk.TxResponse{
+			checkTxError(sdkerrors.ErrWrongSequence),
+			{},
+		}
+
+		b := newBroadcaster()
+		_, err := b.BroadcastMsgs(ctx, msg)
+		Expect(err).To(BeNil())
+		Expect(accounts.syncs()).To(Equal(2))
+		Expect(b.accSeq).To(Equal(uint64(11)))
+
+		// the retry reason is not left in the shared tags
+		Expect(b.svcTags).To(Equal(metrics.Tags{"svc": "tx_broadcaster"}))
+	})
+
+	It("bumps gas prices on insufficient fee up to the ceiling", func() {
+		cfg.MaxGasPrices = sdk.NewDecCoins(sdk.NewInt64DecCoin("inj", 1000000000))
+		cfg.FeeBumpFactor = 2
+		txClient.checkTxResults = []*sdk.TxResponse{
+			checkTxError(sdkerrors.ErrInsufficientFee),
+		}
+
+		_, err := newBroadcaster().BroadcastMsgs(ctx, msg)
+		Expect(err).ToNot(BeNil())
+
+		fees := txClient.broadcastedFees(clientCtx)
+		Expect(fees).To(HaveLen(2))
+		Expect(fees[0].String()).To(Equal("75000000000000inj"))
+		Expect(fees[1].String()).To(Equal("150000000000000inj"))
+	})
+
+	It("doesn't bump gas prices of a denom without a ceiling", func() {
+		cfg.MaxGasPrices = sdk.NewDecCoins(sdk.NewInt64DecCoin("peggy0xdac17f958d2ee523a2206206994597c13d831ec7", 1000000000))
+		cfg.FeeBumpFactor = 2
+		txClient.checkTxResults = []*sdk.TxResponse{
+			checkTxError(sdkerrors.ErrInsufficientFee),
+		}
+
+		_, err := newBroadcaster().BroadcastMsgs(ctx, msg)
+		Expect(err).ToNot(BeNil())
+
+		fees := txClient.broadcastedFees(clientCtx)
+		Expect(fees).To(HaveLen(1))
+		Expect(fees[0].String()).To(Equal("75000000000000inj"))
+	})
+
+	It("reports a Tx not included before ctx is done as unconfirmed", func() {
+		txClient.included = false
+
+		timeoutCtx, cancelFn := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancelFn()
+
+		txResp, err := newBroadcaster().BroadcastMsgs(timeoutCtx, msg)
+		Expect(errors.Cause(err)).To(Equal(ErrTxUnconfirmed))
+		Expect(txResp.TxHash).To(Equal("TXHASH1"))
+	})
+
+	It("fails right away on non-retriable CheckTx errors", func() {
+		txClient.checkTxResults = []*sdk.TxResponse{
+			checkTxError(sdkerrors.ErrUnauthorized),
+		}
+
+		_, err := newBroadcaster().BroadcastMsgs(ctx, msg)
+		Expect(err).ToNot(BeNil())
+		Expect(txClient.broadcastedFees(clientCtx)).To(HaveLen(1))
+	})
+})
+
+func newTestClientContext() client.Context {
+	privKey, err := ethsecp256k1.GenerateKey()
+	Expect(err).To(BeNil())
+
+	passphrase := make([]byte, 32)
+	_, err = rand.Read(passphrase)
+	Expect(err).To(BeNil())
+
+	kb := keyring.NewInMemory(hd.EthSecp256k1Option())
+	armored := cosmcrypto.EncryptArmorPrivKey(privKey, string(passphrase), privKey.Type())
+	Expect(kb.ImportPrivKey("sender", armored, string(passphrase))).To(BeNil())
+
+	clientCtx, err := chainclient.NewClientContext("injective-1", "sender", kb)
+	Expect(err).To(BeNil())
+
+	return clientCtx
+}
+
+func checkTxError(sdkErr *sdkerrors.Error) *sdk.TxResponse {
+	return &sdk.TxResponse{
+		Codespace: sdkErr.Codespace(),
+		Code:      sdkErr.ABCICode(),
+		RawLog:    sdkErr.Error(),
+	}
+}
+
+// fakeTxClient accepts Txs with the scripted CheckTx results, the last one repeats.
+// Accepted Txs are included right away, unless included is false.
+type fakeTxClient struct {
+	txtypes.ServiceClient
+
+	mux            sync.Mutex
+	checkTxResults []*sdk.TxResponse
+	included       bool
+	broadcasted    [][]byte
+}
+
+func newFakeTxClient() *fakeTxClient {
+	return &fakeTxClient{
+		included: true,
+	}
+}
+
+func (c *fakeTxClient) Simulate(ctx context.Context, in *txtypes.SimulateRequest, opts ...grpc.CallOption) (*txtypes.SimulateResponse, error) {
+	return &txtypes.SimulateResponse{
+		GasInfo: &sdk.GasInfo{
+			GasUsed: 100000,
+		},
+	}, nil
+}
+
+func (c *fakeTxClient) BroadcastTx(ctx context.Context, in *txtypes.BroadcastTxRequest, opts ...grpc.CallOption) (*txtypes.BroadcastTxResponse, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.broadcasted = append(c.broadcasted, in.TxBytes)
+
+	txResp := &sdk.TxResponse{}
+	if len(c.checkTxResults) > 0 {
+		*txResp = *c.checkTxResults[0]
+		if len(c.checkTxResults) > 1 {
+			c.checkTxResults = c.checkTxResults[1:]
+		}
+	}
+
+	if txResp.Code == 0 {
+		txResp.TxHash = "TXHASH" + string(rune('0'+len(c.broadcasted)))
+	}
+
+	return &txtypes.BroadcastTxResponse{
+		TxResponse: txResp,
+	}, nil
+}
+
+func (c *fakeTxClient) GetTx(ctx context.Context, in *txtypes.GetTxRequest, opts ...grpc.CallOption) (*txtypes.GetTxResponse, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if !c.included {
+		return nil, status.Error(codes.NotFound, "tx not found")
+	}
+
+	return &txtypes.GetTxResponse{
+		TxResponse: &sdk.TxResponse{
+			TxHash: in.Hash,
+			Height: 100,
+		},
+	}, nil
+}
+
+func (c *fakeTxClient) broadcastedFees(clientCtx client.Context) []sdk.Coins {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	fees := make([]sdk.Coins, 0, len(c.broadcasted))
+	for _, txBytes := range c.broadcasted {
+		tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+		Expect(err).To(BeNil())
+
+		fees = append(fees, tx.(sdk.FeeTx).GetFee())
+	}
+
+	return fees
+}
+
+type fakeAccountRetriever struct {
+	client.AccountRetriever
+
+	mux    sync.Mutex
+	accNum uint64
+	accSeq uint64
+	calls  int
+}
+
+func (r *fakeAccountRetriever) GetAccountNumberSequence(clientCtx client.Context, addr sdk.AccAddress) (uint64, uint64, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.calls++
+	return r.accNum, r.accSeq, nil
+}
+
+func (r *fakeAccountRetriever) syncs() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.calls
+}
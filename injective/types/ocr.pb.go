@@ -908,6 +908,7 @@ type Report struct {
 	ObservationsTimestamp int64                                    `protobuf:"varint,1,opt,name=observations_timestamp,json=observationsTimestamp,proto3" json:"observations_timestamp,omitempty"`
 	Observers             []byte                                   `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers,omitempty"`
 	Observations          []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,rep,name=observations,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"observations"`
+	JuelsPerFeeCoin       *github_com_cosmos_cosmos_sdk_types.Int  `protobuf:"bytes,4,opt,name=juels_per_fee_coin,json=juelsPerFeeCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"juels_per_fee_coin,omitempty"`
 }
 
 func (m *Report) Reset()         { *m = Report{} }
@@ -1437,106 +1438,107 @@ func init() {
 func init() { proto.RegisterFile("injective/ocr/v1beta1/ocr.proto", fileDescriptor_0acb79560f1720fa) }
 
 var fileDescriptor_0acb79560f1720fa = []byte{
-	// 1574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x3b, 0x70, 0x13, 0xd7,
-	0x1a, 0xf6, 0x4a, 0xb2, 0x6c, 0xfd, 0x7a, 0x18, 0x16, 0x1b, 0x84, 0x07, 0x2c, 0xb1, 0x5c, 0xee,
-	0xf5, 0x2d, 0x90, 0x2e, 0xbe, 0x93, 0x64, 0x02, 0x45, 0xc6, 0x36, 0x10, 0x34, 0xc3, 0xc3, 0xb3,
-	0x36, 0x14, 0x69, 0x36, 0x47, 0xbb, 0x47, 0xf2, 0x06, 0xe9, 0x9c, 0xcd, 0x39, 0x47, 0x06, 0xf7,
-	0x99, 0x49, 0x4a, 0x8a, 0x4c, 0x6a, 0xea, 0x74, 0xa9, 0xd2, 0xa5, 0x4a, 0x41, 0x49, 0x99, 0x49,
-	0x41, 0x32, 0x30, 0x99, 0xa1, 0xc8, 0xa4, 0x4f, 0x97, 0x39, 0x8f, 0x95, 0x56, 0x0f, 0x6b, 0x10,
-	0x86, 0x4a, 0x7b, 0xfe, 0xf7, 0xfe, 0x8f, 0xef, 0xfc, 0x2b, 0xa8, 0x84, 0xe4, 0x0b, 0xec, 0x8b,
-	0xf0, 0x00, 0xd7, 0xa9, 0xcf, 0xea, 0x07, 0x57, 0x9a, 0x58, 0xa0, 0x2b, 0xf2, 0xb9, 0x16, 0x31,
-	0x2a, 0xa8, 0xbd, 0xd2, 0x17, 0xa8, 0x49, 0xa2, 0x11, 0x58, 0x5d, 0x6e, 0xd3, 0x36, 0x55, 0x12,
-	0x75, 0xf9, 0xa4, 0x85, 0x57, 0x2b, 0x6d, 0x4a, 0xdb, 0x1d, 0x5c, 0x57, 0xa7, 0x66, 0xaf, 0x55,
-	0x17, 0x61, 0x17, 0x73, 0x81, 0xba, 0x91, 0x11, 0x58, 0xf3, 0x29, 0xef, 0x52, 0x5e, 0x6f, 0x22,
-	0x8e, 0xfb, 0xce, 0x7c, 0x1a, 0x12, 0xcd, 0x77, 0xbe, 0xb2, 0x20, 0xbb, 0x83, 0x18, 0xea, 0x72,
-	0xfb, 0x3c, 0x40, 0x27, 0x24, 0x0f, 0xbd, 0x00, 0x13, 0xda, 0x2d, 0x5b, 0x55, 0x6b, 0x3d, 0xe7,
-	0xe6, 0x24, 0xe5, 0xba, 0x24, 0xd8, 0x1b, 0xb0, 0x12, 0xa1, 0x43, 0xda, 0x13, 0x5e, 0xb3, 0x43,
-	0xfd, 0x87, 0x5e, 0x48, 0x04, 0x66, 0x07, 0xa8, 0x53, 0x4e, 0x55, 0xad, 0xf5, 0x8c, 0x7b, 0x4a,
-	0x33, 0xb7, 0x24, 0xaf, 0x61, 0x58, 0xf6, 0x05, 0x28, 0x74, 0x69, 0xd0, 0xeb, 0x60, 0x0f, 0x05,
-	0xdd, 0x90, 0x94, 0xd3, 0xca, 0x68, 0x5e, 0xd3, 0x36, 0x25, 0xe9, 0x6a, 0xe6, 0xf5, 0xd3, 0x8a,
-	0xe5, 0x7c, 0x9f, 0x02, 0xb8, 0x89, 0x71, 0xb0, 0x4d, 0x49, 0x2b, 0x6c, 0xdb, 0x65, 0x58, 0xe0,
-	0x61, 0x9b, 0x60, 0xc6, 0xcb, 0x56, 0x35, 0xbd, 0x9e, 0x73, 0xe3, 0xa3, 0xed, 0x40, 0x41, 0x30,
-	0x44, 0x78, 0x37, 0x14, 0x42, 0xb2, 0x53, 0x8a, 0x3d, 0x44, 0xb3, 0x0b, 0x60, 0xb5, 0x94, 0xab,
-	0xa2, 0x6b, 0xb5, 0xec, 0x4b, 0x50, 0xa2, 0xc4, 0xdf, 0x47, 0x21, 0xf1, 0x7c, 0x65, 0xbd, 0x9c,
-	0xa9, 0x5a, 0xeb, 0x05, 0xb7, 0x68, 0xa8, 0xc6, 0xe5, 0x87, 0x70, 0x86, 0xb6, 0x5a, 0x49, 0x39,
-	0xef, 0x00, 0x33, 0x1e, 0x52, 0x52, 0x9e, 0x57, 0x2f, 0xb8, 0x12, 0xb3, 0xb5, 0xc2, 0x03, 0xcd,
-	0xb4, 0xff, 0x03, 0x4b, 0x23, 0x7a, 0xe5, 0xac, 0xb2, 0x5f, 0x1a, 0x96, 0xb7, 0x6f, 0x41, 0xd1,
-	0xe4, 0x22, 0x52, 0xf9, 0x2e, 0x2f, 0x54, 0xad, 0xf5, 0xfc, 0xc6, 0xc5, 0xda, 0xc4, 0x7a, 0xd7,
-	0xee, 0x28, 0x59, 0x5d, 0x1a, 0xd7, 0x64, 0x51, 0x9f, 0x9c, 0x9f, 0x2c, 0x28, 0x0d, 0x92, 0xd5,
-	0x20, 0x2d, 0x6a, 0xff, 0x0f, 0x96, 0x3b, 0x48, 0x60, 0x2e, 0xe2, 0xd8, 0x83, 0xb0, 0x8d, 0xb9,
-	0x50, 0x55, 0x2c, 0xb8, 0xb6, 0xe6, 0x69, 0xf9, 0xeb, 0x8a, 0xa3, 0x93, 0x94, 0x8a, 0x93, 0x54,
-	0x00, 0x8b, 0xc4, 0x29, 0x23, 0xb2, 0x6c, 0xc6, 0x8c, 0x4f, 0x7b, 0x44, 0xa8, 0x84, 0x65, 0xdc,
-	0xbc, 0xa6, 0x6d, 0x4b, 0x92, 0x7d, 0x0d, 0x56, 0x87, 0x1d, 0xea, 0xa6, 0x20, 0xbd, 0x6e, 0x13,
-	0x33, 0x95, 0xb1, 0xb4, 0x7b, 0x26, 0xe9, 0x56, 0x35, 0xc6, 0x5d, 0xc5, 0x76, 0x7e, 0xcc, 0x40,
-	0x21, 0xf9, 0x7e, 0xf6, 0x19, 0x58, 0x68, 0x61, 0x1c, 0x78, 0x61, 0x60, 0xfa, 0x2e, 0x2b, 0x8f,
-	0x8d, 0xc0, 0xbe, 0x03, 0xd0, 0x0d, 0x89, 0x87, 0x08, 0x7f, 0x84, 0x99, 0x0a, 0x37, 0xb7, 0x55,
-	0x7b, 0xf6, 0xa2, 0x32, 0xf7, 0xeb, 0x8b, 0xca, 0xbf, 0xdb, 0xa1, 0xd8, 0xef, 0x35, 0x6b, 0x3e,
-	0xed, 0xd6, 0x4d, 0x97, 0xeb, 0x9f, 0xcb, 0x3c, 0x78, 0x58, 0x17, 0x87, 0x11, 0xe6, 0xb5, 0xeb,
-	0xd8, 0x77, 0x73, 0xdd, 0x90, 0x6c, 0x2a, 0x03, 0xca, 0x1c, 0x7a, 0x1c, 0x9b, 0x4b, 0xbf, 0xa5,
-	0x39, 0xf4, 0xd8, 0x98, 0xfb, 0x1c, 0x96, 0xd5, 0xc4, 0x44, 0x98, 0x79, 0xb4, 0xc9, 0x65, 0xcf,
-	0x0b, 0xd9, 0x30, 0x99, 0x99, 0x0d, 0x37, 0x88, 0x70, 0x6d, 0x69, 0x6b, 0x07, 0xb3, 0x7b, 0x03,
-	0x4b, 0x76, 0x13, 0x56, 0xfa, 0x1e, 0x4c, 0x8f, 0xf3, 0x7e, 0x4f, 0xce, 0xee, 0xe2, 0x94, 0x71,
-	0xb1, 0x97, 0x30, 0x35, 0x32, 0xf7, 0xd9, 0xd1, 0xb9, 0xbf, 0x04, 0xa5, 0x1e, 0x09, 0xbf, 0xec,
-	0x61, 0x8f, 0xe1, 0x88, 0x32, 0xa1, 0x1b, 0x77, 0xd1, 0x2d, 0x6a, 0xaa, 0xab, 0x89, 0x76, 0x15,
-	0xf2, 0x01, 0xe6, 0x3e, 0x0b, 0x23, 0x95, 0x82, 0x45, 0x3d, 0xe9, 0x09, 0x92, 0xf4, 0xa3, 0x8a,
-	0xac, 0xa1, 0x20, 0xa7, 0xfd, 0x48, 0x8a, 0x02, 0x02, 0xfb, 0x22, 0x14, 0x9b, 0x61, 0xa7, 0x13,
-	0x92, 0xb6, 0x91, 0x00, 0x25, 0x51, 0x30, 0x44, 0x25, 0xe4, 0x7c, 0x9d, 0x82, 0xd2, 0x36, 0x25,
-	0x82, 0x21, 0xdf, 0xf4, 0xd5, 0x58, 0xb3, 0x5a, 0xe3, 0xcd, 0x9a, 0x80, 0x93, 0xd4, 0x74, 0x38,
-	0x49, 0x1f, 0x05, 0x27, 0x99, 0xa3, 0xe1, 0x64, 0x7e, 0x46, 0x38, 0xc9, 0xce, 0x08, 0x27, 0x0b,
-	0x93, 0xe0, 0xc4, 0x79, 0x62, 0xc1, 0xc9, 0x5d, 0x6c, 0x92, 0xb0, 0xc3, 0x68, 0x44, 0x39, 0xea,
-	0xd8, 0xcb, 0x30, 0x2f, 0x42, 0xd1, 0xc1, 0x66, 0x8c, 0xf4, 0x61, 0xb4, 0x36, 0xa9, 0xf1, 0xda,
-	0x7c, 0x0c, 0x59, 0xe3, 0x2d, 0xad, 0x50, 0xe9, 0xc2, 0x11, 0xa8, 0x34, 0x80, 0x1d, 0xd7, 0x28,
-	0x5c, 0x5d, 0xfc, 0xe6, 0x69, 0x65, 0xee, 0xf5, 0xd3, 0xca, 0x9c, 0xf3, 0x67, 0x46, 0xe3, 0x92,
-	0x8c, 0x06, 0x33, 0x11, 0xe2, 0x29, 0x83, 0x3d, 0x0c, 0x3f, 0xe3, 0x49, 0x4d, 0xcf, 0x98, 0xd4,
-	0xcc, 0x8c, 0x49, 0x9d, 0x9f, 0x88, 0xd1, 0xc3, 0x70, 0x93, 0x7d, 0xb7, 0x70, 0xb3, 0xf0, 0xbe,
-	0xe0, 0x66, 0xf1, 0xfd, 0xc3, 0x4d, 0xee, 0xdd, 0xc1, 0xcd, 0x38, 0x9e, 0xc0, 0x1b, 0xe0, 0x49,
-	0x7e, 0xac, 0x67, 0x25, 0x16, 0x9c, 0xde, 0xc5, 0x62, 0x0b, 0x09, 0x7f, 0xff, 0x1d, 0x8d, 0x41,
-	0x02, 0x28, 0xd2, 0xd3, 0x81, 0x22, 0x33, 0x01, 0x28, 0x86, 0x81, 0x74, 0x7e, 0x14, 0x48, 0xef,
-	0xc2, 0x92, 0x9a, 0x85, 0xa8, 0x3f, 0x1e, 0xe5, 0x6c, 0x35, 0xbd, 0x9e, 0xdf, 0xb8, 0x34, 0x65,
-	0xd8, 0x06, 0xb3, 0xe4, 0x96, 0x5a, 0x43, 0xe7, 0xc4, 0xe0, 0x6d, 0x40, 0xf9, 0x1e, 0x43, 0x7e,
-	0x07, 0x27, 0x6a, 0xc9, 0x15, 0xf4, 0x71, 0xfb, 0xb4, 0x9c, 0x6c, 0xf9, 0xa4, 0x36, 0xa9, 0xa2,
-	0x6b, 0x4e, 0xce, 0x03, 0x38, 0xf9, 0x29, 0xe2, 0x2e, 0x0e, 0xbb, 0xcd, 0x1e, 0xe3, 0xb8, 0x8b,
-	0xa5, 0xf0, 0x26, 0x94, 0xd8, 0x10, 0x45, 0x29, 0xe5, 0x37, 0xce, 0xd6, 0x74, 0x7d, 0x6b, 0x72,
-	0x8d, 0xec, 0xc7, 0xb7, 0x4d, 0x43, 0xe2, 0x8e, 0x28, 0x38, 0xf7, 0x61, 0x7e, 0x07, 0x1d, 0x62,
-	0x6c, 0xff, 0x17, 0x4e, 0x24, 0xb2, 0xe3, 0xa1, 0x20, 0x60, 0xa6, 0x1c, 0x4b, 0x09, 0xfa, 0x66,
-	0x10, 0x30, 0x09, 0xe1, 0x11, 0x3a, 0x94, 0xfa, 0x5a, 0xcc, 0x54, 0xc6, 0xd0, 0xa4, 0x88, 0xf3,
-	0xb3, 0x05, 0x85, 0xa1, 0x36, 0xba, 0x09, 0x59, 0x33, 0x57, 0xd6, 0x5b, 0xcd, 0x95, 0xd1, 0xb6,
-	0x3f, 0x80, 0xd3, 0x89, 0x59, 0xe2, 0x5e, 0x7f, 0x81, 0x56, 0x51, 0xa4, 0xdd, 0x95, 0x24, 0x77,
-	0x2f, 0x66, 0x4a, 0xb5, 0xe4, 0x80, 0x24, 0xd4, 0xd2, 0x5a, 0x2d, 0xc9, 0xed, 0xab, 0x39, 0xd7,
-	0xa0, 0x78, 0x23, 0xa2, 0xfe, 0xfe, 0x26, 0x09, 0x5c, 0xda, 0x23, 0x81, 0xec, 0x54, 0x2c, 0x09,
-	0xe6, 0xda, 0xd2, 0x07, 0x49, 0x65, 0x92, 0x6d, 0x76, 0x6b, 0x7d, 0x70, 0x7e, 0xb0, 0x20, 0xab,
-	0xc7, 0x63, 0x4a, 0xd4, 0xd6, 0xb4, 0xa8, 0xcf, 0x41, 0x4e, 0x33, 0xf4, 0x55, 0x28, 0x21, 0x70,
-	0x40, 0xb0, 0x5d, 0x28, 0x24, 0xd5, 0xf4, 0x08, 0xcc, 0x9c, 0xd8, 0x21, 0x1b, 0xce, 0x77, 0x16,
-	0x14, 0x74, 0xcc, 0x7b, 0x74, 0x37, 0x6c, 0xab, 0x6b, 0x7e, 0xd2, 0x8a, 0x6a, 0xee, 0x70, 0xb3,
-	0x9c, 0xf6, 0xb3, 0x92, 0x9a, 0x98, 0x95, 0x74, 0x22, 0x2b, 0x72, 0xea, 0xf0, 0x63, 0xc1, 0x90,
-	0xb7, 0x8f, 0xf8, 0xbe, 0xd9, 0xed, 0x73, 0x8a, 0x72, 0x0b, 0xf1, 0x7d, 0xd9, 0xff, 0x1a, 0x67,
-	0x0c, 0xe4, 0x9b, 0x93, 0xf3, 0xad, 0x05, 0x4b, 0x37, 0x0e, 0x30, 0x11, 0x7a, 0x72, 0x76, 0x50,
-	0x18, 0xcc, 0xd2, 0xb2, 0xe7, 0x01, 0x22, 0xd9, 0xe6, 0xc9, 0x86, 0xcd, 0x29, 0x8a, 0x62, 0x7f,
-	0x04, 0x59, 0xd4, 0x55, 0xeb, 0x88, 0xbe, 0x4f, 0x8f, 0x1e, 0xa0, 0xad, 0x8c, 0xcc, 0xaf, 0x6b,
-	0xc4, 0x9d, 0xbf, 0x2c, 0xb0, 0x55, 0x58, 0x1a, 0xf3, 0xef, 0x47, 0x01, 0x12, 0x38, 0xb0, 0x6f,
-	0xc1, 0x82, 0xdf, 0x63, 0x0c, 0x9b, 0xfd, 0x66, 0x76, 0x28, 0x8e, 0xd5, 0xed, 0x06, 0x2c, 0xaa,
-	0xbc, 0xc9, 0x2b, 0x39, 0xf5, 0x76, 0xa6, 0x94, 0x7e, 0x23, 0xb0, 0xb7, 0x01, 0x7a, 0x3a, 0x3e,
-	0x0f, 0xc5, 0x2f, 0xba, 0x5a, 0xd3, 0x5f, 0xa4, 0xb5, 0xf8, 0x8b, 0xb4, 0xd6, 0xef, 0xbe, 0xad,
-	0x45, 0xe9, 0xe8, 0xc9, 0x6f, 0x15, 0xcb, 0xcd, 0x19, 0xbd, 0x4d, 0x21, 0x3f, 0x66, 0x8a, 0xea,
-	0x85, 0xef, 0xe2, 0x47, 0x7a, 0x24, 0x92, 0x11, 0x5a, 0xc7, 0x8b, 0xf0, 0x3c, 0x00, 0x17, 0x88,
-	0xc9, 0x08, 0x9b, 0x87, 0x71, 0x95, 0x0c, 0x65, 0xeb, 0x50, 0xbe, 0x40, 0xcc, 0x9e, 0xf5, 0x05,
-	0x8c, 0xde, 0xa6, 0x70, 0xee, 0xc0, 0x09, 0x15, 0xff, 0x5e, 0xbf, 0x43, 0x82, 0x63, 0x34, 0xb9,
-	0xf3, 0x47, 0x1a, 0x96, 0xe3, 0x7c, 0x0c, 0x01, 0xde, 0x91, 0xab, 0x54, 0x0d, 0x4e, 0xa1, 0x76,
-	0x9b, 0xe1, 0x36, 0x12, 0x94, 0x79, 0x43, 0xc5, 0x2d, 0xba, 0x27, 0x07, 0x2c, 0xd7, 0x24, 0x65,
-	0x80, 0x9c, 0xe9, 0x63, 0x21, 0x67, 0x15, 0xf2, 0x89, 0xa9, 0xd0, 0x1f, 0x3d, 0x6e, 0x92, 0x34,
-	0x05, 0xa5, 0xe6, 0xa7, 0xa1, 0xd4, 0x28, 0x0e, 0x65, 0x8f, 0x8f, 0x43, 0xc3, 0xc8, 0xb7, 0x30,
-	0x8a, 0x7c, 0x63, 0xf5, 0x5a, 0x9c, 0x50, 0xaf, 0xdb, 0xb0, 0xa4, 0x4a, 0xe4, 0x21, 0x12, 0xe8,
-	0x34, 0xab, 0xb5, 0x28, 0xbf, 0xf1, 0xaf, 0x23, 0xee, 0xef, 0x21, 0xa4, 0x77, 0x8b, 0x38, 0x79,
-	0x74, 0xfe, 0xb6, 0xa0, 0xa4, 0xea, 0xac, 0x57, 0x97, 0x5d, 0x2c, 0xde, 0xac, 0x6b, 0x3e, 0x81,
-	0x73, 0x11, 0xc3, 0x07, 0x21, 0xed, 0xf1, 0x89, 0x9f, 0xde, 0xfa, 0xd6, 0x3a, 0x1b, 0xcb, 0x8c,
-	0x7d, 0x7c, 0x1f, 0x63, 0xd5, 0xb7, 0x6f, 0x82, 0xf9, 0xac, 0xf2, 0x42, 0xd2, 0xa2, 0xaa, 0xe2,
-	0xd3, 0xb7, 0x97, 0xc1, 0x3f, 0x14, 0x2e, 0xf8, 0xfd, 0xe7, 0x2d, 0xff, 0xd9, 0xcb, 0x35, 0xeb,
-	0xf9, 0xcb, 0x35, 0xeb, 0xf7, 0x97, 0x6b, 0xd6, 0x93, 0x57, 0x6b, 0x73, 0xcf, 0x5f, 0xad, 0xcd,
-	0xfd, 0xf2, 0x6a, 0x6d, 0xee, 0xb3, 0x46, 0xa2, 0xb8, 0x8d, 0xd8, 0xec, 0x6d, 0xd4, 0xe4, 0xf5,
-	0xbe, 0x93, 0xcb, 0x3e, 0x65, 0x38, 0x79, 0x94, 0xfb, 0x7b, 0x5d, 0xff, 0x41, 0xc2, 0xd5, 0x7f,
-	0x6a, 0xaa, 0x07, 0x9a, 0x59, 0x35, 0xc0, 0xff, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0xae, 0x33,
-	0x35, 0xdd, 0x71, 0x13, 0x00, 0x00,
+	// 1592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x58, 0xcd, 0x8f, 0xd3, 0x56,
+	0x10, 0x5f, 0xc7, 0xf9, 0x9c, 0x7c, 0x2c, 0x98, 0x5d, 0x08, 0x2b, 0xca, 0x82, 0x29, 0xfd, 0x92,
+	0x48, 0xca, 0x56, 0x6d, 0x55, 0x38, 0x54, 0x9b, 0x05, 0xca, 0x4a, 0x7c, 0xac, 0xcc, 0x42, 0xa5,
+	0x5e, 0x5c, 0xc7, 0x7e, 0xc9, 0x1a, 0x12, 0x3f, 0xd7, 0x76, 0x16, 0xf6, 0x5e, 0xa9, 0x55, 0x4f,
+	0x1c, 0xaa, 0x9e, 0x39, 0xf7, 0x1f, 0xe8, 0xad, 0xa7, 0x1e, 0x38, 0x72, 0xac, 0x7a, 0xa0, 0x55,
+	0xab, 0x4a, 0x3d, 0xa0, 0xde, 0x7b, 0xeb, 0xbc, 0x0f, 0x27, 0x76, 0x92, 0x8d, 0x08, 0xbb, 0x1c,
+	0xac, 0xf8, 0xcd, 0xcc, 0x9b, 0xf7, 0x3c, 0x33, 0xbf, 0xdf, 0x9b, 0x17, 0x58, 0x75, 0xbd, 0xfb,
+	0xc4, 0x8e, 0xdc, 0x5d, 0xd2, 0xa4, 0x76, 0xd0, 0xdc, 0xbd, 0xd8, 0x26, 0x91, 0x75, 0x91, 0xbd,
+	0x37, 0xfc, 0x80, 0x46, 0x54, 0x5b, 0x1e, 0x1a, 0x34, 0x98, 0x50, 0x1a, 0xac, 0x2c, 0x75, 0x69,
+	0x97, 0x72, 0x8b, 0x26, 0x7b, 0x13, 0xc6, 0x2b, 0xab, 0x5d, 0x4a, 0xbb, 0x3d, 0xd2, 0xe4, 0xa3,
+	0xf6, 0xa0, 0xd3, 0x8c, 0xdc, 0x3e, 0x09, 0x23, 0xab, 0xef, 0x4b, 0x83, 0xd3, 0x36, 0x0d, 0xfb,
+	0x34, 0x6c, 0xb6, 0xad, 0x90, 0x0c, 0x17, 0xb3, 0xa9, 0xeb, 0x09, 0xbd, 0xfe, 0xb5, 0x02, 0xf9,
+	0x2d, 0x2b, 0xb0, 0xfa, 0xa1, 0xf6, 0x06, 0x40, 0xcf, 0xf5, 0x1e, 0x98, 0x0e, 0xf1, 0x68, 0xbf,
+	0xae, 0x9c, 0x51, 0xde, 0x29, 0x19, 0x25, 0x26, 0xb9, 0xc2, 0x04, 0xda, 0x1a, 0x2c, 0xfb, 0xd6,
+	0x1e, 0x1d, 0x44, 0x66, 0xbb, 0x47, 0xed, 0x07, 0xa6, 0xeb, 0x45, 0x24, 0xd8, 0xb5, 0x7a, 0xf5,
+	0x0c, 0x5a, 0x66, 0x8d, 0x63, 0x42, 0xd9, 0x62, 0xba, 0x4d, 0xa9, 0xd2, 0xce, 0x42, 0xa5, 0x4f,
+	0x9d, 0x41, 0x8f, 0x98, 0x96, 0xd3, 0x77, 0xbd, 0xba, 0xca, 0x9d, 0x96, 0x85, 0x6c, 0x9d, 0x89,
+	0x2e, 0x65, 0xff, 0x79, 0xb2, 0xaa, 0xe8, 0x3f, 0x66, 0x00, 0xae, 0x11, 0xe2, 0x6c, 0x50, 0xaf,
+	0xe3, 0x76, 0xb5, 0x3a, 0x14, 0x42, 0xb7, 0xeb, 0x91, 0x20, 0xc4, 0x7d, 0xa8, 0x38, 0x25, 0x1e,
+	0x6a, 0x3a, 0x54, 0xa2, 0xc0, 0xf2, 0xc2, 0xbe, 0x1b, 0x45, 0x4c, 0x9d, 0xe1, 0xea, 0x94, 0x4c,
+	0xab, 0x80, 0xd2, 0xe1, 0x4b, 0x55, 0x0d, 0xa5, 0xa3, 0x9d, 0x87, 0x1a, 0xf5, 0xec, 0x1d, 0xcb,
+	0xf5, 0x4c, 0x9b, 0x7b, 0xaf, 0x67, 0x51, 0x55, 0x31, 0xaa, 0x52, 0x2a, 0x97, 0xfc, 0x08, 0x4e,
+	0xd0, 0x4e, 0x27, 0x69, 0x67, 0xee, 0xa2, 0x33, 0x97, 0x7a, 0xf5, 0x1c, 0xff, 0xc0, 0xe5, 0x58,
+	0x2d, 0x26, 0xdc, 0x13, 0x4a, 0xed, 0x6d, 0x58, 0x1c, 0x9b, 0x57, 0xcf, 0x73, 0xff, 0xb5, 0xb4,
+	0xbd, 0x76, 0x1d, 0xaa, 0x32, 0x16, 0x3e, 0x8f, 0x77, 0xbd, 0x80, 0x66, 0xe5, 0xb5, 0x73, 0x8d,
+	0xa9, 0xf9, 0x6e, 0xdc, 0xe4, 0xb6, 0x22, 0x35, 0x86, 0x8c, 0xa2, 0x18, 0xe9, 0x3f, 0x2b, 0x50,
+	0x1b, 0x05, 0x6b, 0xd3, 0xeb, 0x50, 0xed, 0x7d, 0x58, 0xea, 0x59, 0x11, 0x66, 0x3e, 0xde, 0xbb,
+	0xe3, 0x76, 0x71, 0xc4, 0xb3, 0x58, 0x31, 0x34, 0xa1, 0x13, 0xf6, 0x57, 0xb8, 0x46, 0x04, 0x29,
+	0x13, 0x07, 0x09, 0x47, 0x5e, 0x1c, 0x32, 0x8f, 0xa5, 0x4d, 0xba, 0xb1, 0xe9, 0xc0, 0x8b, 0x78,
+	0xc0, 0xb2, 0x46, 0x59, 0xc8, 0x36, 0x98, 0x48, 0xbb, 0x0c, 0x2b, 0xe9, 0x05, 0x45, 0x51, 0x78,
+	0x83, 0x7e, 0x9b, 0x04, 0x3c, 0x62, 0xaa, 0x71, 0x22, 0xb9, 0x2c, 0x2f, 0x8c, 0x5b, 0x5c, 0xad,
+	0xff, 0x94, 0x85, 0x4a, 0xf2, 0xfb, 0xb4, 0x13, 0x50, 0xe8, 0xe0, 0x07, 0x99, 0xae, 0x23, 0xeb,
+	0x2e, 0xcf, 0x86, 0x9b, 0x8e, 0x76, 0x13, 0x00, 0x8b, 0xc4, 0xc4, 0xe4, 0x3e, 0x44, 0xb7, 0x6c,
+	0xbb, 0xa5, 0x56, 0xe3, 0xe9, 0xf3, 0xd5, 0x85, 0xdf, 0x9e, 0xaf, 0xbe, 0xd5, 0x75, 0xa3, 0x9d,
+	0x41, 0xbb, 0x61, 0xd3, 0x7e, 0x53, 0x56, 0xb9, 0xf8, 0xb9, 0x10, 0x3a, 0x0f, 0x9a, 0xd1, 0x9e,
+	0x4f, 0xc2, 0xc6, 0x15, 0x62, 0x1b, 0x25, 0xf4, 0xb0, 0xce, 0x1d, 0x70, 0x77, 0xd6, 0xa3, 0xd8,
+	0x9d, 0xfa, 0x8a, 0xee, 0xac, 0x47, 0xd2, 0xdd, 0x97, 0x18, 0x75, 0x86, 0x18, 0x9f, 0x04, 0x26,
+	0x6d, 0x87, 0xac, 0xe6, 0x23, 0x56, 0x30, 0xd9, 0xb9, 0x1d, 0x23, 0x62, 0x30, 0x4b, 0xe8, 0x6b,
+	0x8b, 0x04, 0xb7, 0x47, 0x9e, 0xb4, 0x36, 0x2c, 0x0f, 0x57, 0x90, 0x35, 0x1e, 0x0e, 0x6b, 0x72,
+	0xfe, 0x25, 0x8e, 0xc9, 0x25, 0xb6, 0x13, 0xae, 0xc6, 0x70, 0x9f, 0x1f, 0xc7, 0x3d, 0xe2, 0x67,
+	0xe0, 0xb9, 0x5f, 0x0d, 0x88, 0x19, 0x10, 0x9f, 0x06, 0x91, 0x28, 0xdc, 0xa2, 0x51, 0x15, 0x52,
+	0x43, 0x08, 0xb5, 0x33, 0x50, 0x76, 0x48, 0x68, 0x07, 0xae, 0xcf, 0x43, 0x50, 0x14, 0x48, 0x4f,
+	0x88, 0xd8, 0x3a, 0x3c, 0xc9, 0x82, 0x0a, 0x4a, 0x62, 0x1d, 0x26, 0xe1, 0x44, 0xa0, 0x9d, 0x83,
+	0x6a, 0xdb, 0xed, 0xe1, 0xba, 0x5d, 0x69, 0x01, 0xdc, 0xa2, 0x22, 0x85, 0xdc, 0x48, 0xff, 0x26,
+	0x03, 0x35, 0xac, 0x27, 0x0c, 0x85, 0x2d, 0xeb, 0x6a, 0xa2, 0x58, 0x95, 0xc9, 0x62, 0x4d, 0xd0,
+	0x49, 0x66, 0x36, 0x9d, 0xa8, 0xfb, 0xd1, 0x49, 0x76, 0x7f, 0x3a, 0xc9, 0xcd, 0x49, 0x27, 0xf9,
+	0x39, 0xe9, 0xa4, 0x30, 0x8d, 0x4e, 0xf4, 0xc7, 0x0a, 0x1c, 0xbd, 0x43, 0x64, 0x10, 0xb6, 0x02,
+	0xea, 0xd3, 0x10, 0x09, 0x77, 0x09, 0x72, 0x91, 0x1b, 0xf5, 0x88, 0x84, 0x91, 0x18, 0x8c, 0xe7,
+	0x26, 0x33, 0x99, 0x9b, 0x4f, 0x20, 0x2f, 0x57, 0x53, 0x39, 0x2b, 0x9d, 0xdd, 0x87, 0x95, 0x46,
+	0xb4, 0x63, 0xc8, 0x09, 0x97, 0x8a, 0xdf, 0x3e, 0x59, 0x5d, 0x40, 0x12, 0x5f, 0xd0, 0x5f, 0x64,
+	0x05, 0x2f, 0xb1, 0xdd, 0x90, 0x20, 0x72, 0xc9, 0x0c, 0x60, 0xa7, 0xe9, 0x67, 0x32, 0xa8, 0xea,
+	0x9c, 0x41, 0xcd, 0xce, 0x19, 0xd4, 0xdc, 0x54, 0x8e, 0x4e, 0xd3, 0x4d, 0xfe, 0x70, 0xe9, 0xa6,
+	0xf0, 0xba, 0xe8, 0xa6, 0xf8, 0xfa, 0xe9, 0xa6, 0x74, 0x78, 0x74, 0x33, 0xc9, 0x27, 0xf0, 0x12,
+	0x7c, 0x52, 0x9e, 0xa8, 0x59, 0xc6, 0x05, 0xc7, 0x11, 0x01, 0x2d, 0x2b, 0xb2, 0x77, 0x0e, 0x09,
+	0x06, 0x09, 0xa2, 0x50, 0x67, 0x13, 0x45, 0x76, 0x0a, 0x51, 0xa4, 0x89, 0x34, 0x37, 0x4e, 0xa4,
+	0xb7, 0x60, 0x91, 0x63, 0xc1, 0x1f, 0xc2, 0x03, 0x2b, 0x4c, 0x45, 0xb0, 0x9d, 0x9f, 0x01, 0xb6,
+	0x11, 0x96, 0x8c, 0x5a, 0x27, 0x35, 0x4e, 0x00, 0x6f, 0x0d, 0xea, 0xb7, 0x91, 0x11, 0x7b, 0x24,
+	0x91, 0xcb, 0x90, 0x53, 0x5f, 0xa8, 0x1d, 0x67, 0xc8, 0x66, 0x6f, 0xbc, 0x93, 0xaa, 0x1a, 0x72,
+	0xa4, 0xdf, 0x83, 0xa3, 0x9f, 0x59, 0xa1, 0x41, 0xdc, 0x7e, 0x7b, 0x10, 0x84, 0xa4, 0x4f, 0x98,
+	0xf1, 0x3a, 0xd4, 0x82, 0x94, 0x84, 0x4f, 0x2a, 0xaf, 0x9d, 0x6c, 0x88, 0xfc, 0x36, 0x58, 0x1b,
+	0x39, 0xdc, 0xdf, 0x06, 0xb6, 0x91, 0xc6, 0xd8, 0x04, 0xfd, 0x2e, 0xe4, 0xb6, 0xac, 0x3d, 0x42,
+	0xb4, 0x77, 0xe1, 0x48, 0x22, 0x3a, 0xc8, 0xe9, 0x4e, 0x20, 0xd3, 0xb1, 0x98, 0x90, 0xaf, 0xa3,
+	0x98, 0x51, 0x38, 0x76, 0x8f, 0x6c, 0xbe, 0x30, 0x93, 0x99, 0x91, 0x32, 0x66, 0xa2, 0xff, 0xa2,
+	0x40, 0x25, 0x55, 0x46, 0xd7, 0x20, 0x2f, 0x71, 0xa5, 0xbc, 0x12, 0xae, 0xe4, 0x6c, 0xed, 0x43,
+	0x38, 0x9e, 0xc0, 0x52, 0x68, 0x0e, 0x1b, 0x68, 0xbe, 0x0b, 0x15, 0x29, 0x25, 0xa1, 0xdd, 0x8e,
+	0x95, 0x6c, 0x5a, 0x12, 0x20, 0x89, 0x69, 0xaa, 0x98, 0x96, 0xd4, 0x0e, 0xa7, 0xe9, 0x97, 0xa1,
+	0x7a, 0xd5, 0xa7, 0xf6, 0xce, 0xba, 0xe7, 0x18, 0x98, 0x07, 0x87, 0x55, 0x2a, 0x61, 0x02, 0x79,
+	0x6c, 0x89, 0x01, 0x93, 0x06, 0x4c, 0x2d, 0x7b, 0x6b, 0x31, 0xd0, 0xbf, 0xcb, 0x40, 0x5e, 0xc0,
+	0x63, 0xc6, 0xae, 0x95, 0x59, 0xbb, 0x3e, 0x05, 0x25, 0xa1, 0x10, 0x47, 0x21, 0xa3, 0xc0, 0x91,
+	0x40, 0x33, 0xa0, 0x92, 0x9c, 0x26, 0x20, 0x30, 0x77, 0x60, 0x53, 0x3e, 0xb4, 0xcf, 0x41, 0xbb,
+	0x3f, 0x20, 0xbd, 0x90, 0x53, 0x0a, 0x16, 0xb0, 0xc9, 0xee, 0x1e, 0xb2, 0x41, 0x7a, 0x6f, 0x0e,
+	0x2a, 0x59, 0xe4, 0x5e, 0x90, 0x4b, 0x10, 0x14, 0xac, 0xee, 0xf4, 0x1f, 0xb0, 0x20, 0x44, 0x30,
+	0xb6, 0xe9, 0x1d, 0x04, 0x29, 0xeb, 0x1f, 0xa6, 0xf5, 0xbe, 0xb2, 0x39, 0x90, 0x5d, 0xef, 0x30,
+	0xdc, 0x99, 0xa9, 0xe1, 0x56, 0x13, 0xe1, 0x66, 0x70, 0x26, 0x8f, 0x30, 0x8d, 0xe6, 0x8e, 0x15,
+	0xee, 0xc8, 0x4b, 0x43, 0x89, 0x4b, 0xae, 0xa3, 0x80, 0x01, 0x4b, 0x10, 0x98, 0x3c, 0x4b, 0xe4,
+	0x48, 0xff, 0x5e, 0x81, 0xc5, 0xab, 0xbb, 0x58, 0xb7, 0x02, 0x92, 0x5b, 0x96, 0xeb, 0xcc, 0x83,
+	0x05, 0x5c, 0xd5, 0x67, 0xf8, 0x49, 0x22, 0xa1, 0xc4, 0x25, 0x5c, 0xfd, 0x31, 0x96, 0x7d, 0x9f,
+	0xf7, 0x39, 0xe2, 0xa0, 0xde, 0x1f, 0x99, 0xad, 0x2c, 0x4b, 0x9c, 0x21, 0xcd, 0xf5, 0x7f, 0x15,
+	0xd0, 0xf8, 0xb6, 0xc4, 0x61, 0x72, 0xd7, 0x77, 0xb0, 0x39, 0x77, 0xf0, 0x56, 0x52, 0xb0, 0x07,
+	0x41, 0x40, 0x64, 0xe3, 0x34, 0x3f, 0xc7, 0xc7, 0xd3, 0xb5, 0x4d, 0x28, 0xf2, 0xb8, 0xb1, 0xb3,
+	0x3e, 0xf3, 0x6a, 0xae, 0xf8, 0x7c, 0x6c, 0x0e, 0x36, 0x00, 0x06, 0x62, 0x7f, 0xa6, 0x15, 0x7f,
+	0xe8, 0x4a, 0x43, 0x5c, 0x75, 0x1b, 0xf1, 0x55, 0xb7, 0x31, 0x2c, 0xeb, 0x56, 0x91, 0x2d, 0xf4,
+	0xf8, 0xf7, 0x55, 0xc5, 0x28, 0xc9, 0x79, 0xeb, 0x11, 0xbb, 0x25, 0x55, 0xf9, 0x07, 0xdf, 0x22,
+	0x0f, 0x05, 0xd6, 0x92, 0x3b, 0x54, 0x0e, 0xb6, 0x43, 0xcc, 0x12, 0x2e, 0x1d, 0xb0, 0x1d, 0xb6,
+	0xf7, 0xe2, 0x2c, 0x49, 0x49, 0x6b, 0x8f, 0x7d, 0x40, 0xac, 0x9e, 0xf7, 0x03, 0xe4, 0x3c, 0xfc,
+	0x80, 0x9b, 0x70, 0x84, 0xef, 0x7f, 0x7b, 0x58, 0x21, 0xce, 0x01, 0x8a, 0x5c, 0xff, 0x5b, 0x85,
+	0xa5, 0x38, 0x1e, 0x29, 0x26, 0xdd, 0xb7, 0x47, 0x6b, 0xc0, 0x31, 0xab, 0xdb, 0x0d, 0x48, 0xd7,
+	0x8a, 0x68, 0x60, 0xa6, 0x92, 0x5b, 0x35, 0x8e, 0x8e, 0x54, 0x86, 0x0c, 0xca, 0x88, 0x92, 0xd5,
+	0x03, 0x51, 0x32, 0x9e, 0xd3, 0x09, 0x54, 0x08, 0xb2, 0x30, 0x92, 0xa2, 0x19, 0xf4, 0x97, 0x9b,
+	0x45, 0x7f, 0xe3, 0x04, 0x97, 0x3f, 0x04, 0x82, 0x4b, 0x51, 0x6a, 0x61, 0x9c, 0x52, 0x27, 0xf2,
+	0x55, 0x9c, 0x92, 0xaf, 0x1b, 0xb0, 0xc8, 0x53, 0x84, 0x8d, 0xa2, 0x23, 0xc2, 0xcc, 0xfb, 0xad,
+	0xf2, 0xda, 0x9b, 0xfb, 0x34, 0x06, 0xa9, 0x23, 0xc4, 0xa8, 0x92, 0xe4, 0x50, 0xff, 0x4f, 0x81,
+	0x1a, 0xcf, 0xb3, 0xe8, 0x89, 0xb0, 0x43, 0x7a, 0xb9, 0xaa, 0xf9, 0x14, 0x4e, 0xf9, 0x01, 0xd9,
+	0x75, 0xe9, 0x20, 0x9c, 0x7a, 0xa7, 0x17, 0xc7, 0xe1, 0xc9, 0xd8, 0x66, 0xe2, 0x56, 0x7f, 0x80,
+	0x3b, 0x04, 0x56, 0x8e, 0xbc, 0xaf, 0x99, 0xae, 0xd7, 0xa1, 0x3c, 0xe3, 0xb3, 0xdb, 0xa2, 0xd1,
+	0x5f, 0x1f, 0x06, 0xd8, 0xc3, 0xf7, 0x96, 0xfd, 0xf4, 0xcf, 0xd3, 0xca, 0x33, 0x7c, 0xfe, 0xc0,
+	0xe7, 0xf1, 0x5f, 0xa7, 0x17, 0x9e, 0xe1, 0xf3, 0x2b, 0x3e, 0x5f, 0x6c, 0x26, 0x92, 0xbb, 0x19,
+	0xbb, 0xbd, 0x61, 0xb5, 0xc3, 0xe6, 0x70, 0x91, 0x0b, 0x36, 0x0d, 0x48, 0x72, 0xc8, 0x2e, 0x06,
+	0x4d, 0xf1, 0xcf, 0x4b, 0xc8, 0xff, 0xac, 0xe3, 0x35, 0xd0, 0xce, 0x73, 0x00, 0x7f, 0xf0, 0x3f,
+	0x83, 0x2b, 0x4c, 0x15, 0xca, 0x13, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -2363,6 +2365,18 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JuelsPerFeeCoin != nil {
+		{
+			size := m.JuelsPerFeeCoin.Size()
+			i -= size
+			if _, err := m.JuelsPerFeeCoin.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOcr(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Observations) > 0 {
 		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3157,6 +3171,10 @@ func (m *Report) Size() (n int) {
 			n += 1 + l + sovOcr(uint64(l))
 		}
 	}
+	if m.JuelsPerFeeCoin != nil {
+		l = m.JuelsPerFeeCoin.Size()
+		n += 1 + l + sovOcr(uint64(l))
+	}
 	return n
 }
 
@@ -5903,6 +5921,42 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JuelsPerFeeCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.JuelsPerFeeCoin = &v
+			if err := m.JuelsPerFeeCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -23,11 +23,11 @@ type StatterConfig struct {
 func (m *StatterConfig) BaseTags() []string {
 	var baseTags []string
 
-	if len(config.EnvName) > 0 {
-		baseTags = append(baseTags, "env", config.EnvName)
+	if len(m.EnvName) > 0 {
+		baseTags = append(baseTags, "env", m.EnvName)
 	}
-	if len(config.HostName) > 0 {
-		baseTags = append(baseTags, "machine", config.HostName)
+	if len(m.HostName) > 0 {
+		baseTags = append(baseTags, "machine", m.HostName)
 	}
 
 	return baseTags
@@ -18,6 +18,10 @@ func ReportClosureFuncError(name string, tags ...Tags) {
 	reportFunc(name, "error", tags...)
 }
 
+func ReportClosureFuncSuccess(name string, tags ...Tags) {
+	reportFunc(name, "success", tags...)
+}
+
 func ReportFuncStatus(tags ...Tags) {
 	fn := funcName()
 	reportFunc(fn, "status", tags...)
@@ -130,14 +134,16 @@ func funcName() string {
 
 type Tags map[string]string
 
+// With returns a copy of the tags with k set to v. The receiver is not modified,
+// since tags are usually shared between calls and goroutines.
 func (t Tags) With(k, v string) Tags {
-	if t == nil || len(t) == 0 {
-		return map[string]string{
-			k: v,
-		}
+	tags := make(Tags, len(t)+1)
+	for tk, tv := range t {
+		tags[tk] = tv
 	}
-	t[k] = v
-	return t
+
+	tags[k] = v
+	return tags
 }
 
 func joinTags(tags ...Tags) string {
@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/xlab/suplog"
+)
+
+func TestMetrics(t *testing.T) {
+	if !testing.Verbose() {
+		log.DefaultLogger.SetLevel(log.FatalLevel)
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Metrics Test Suite")
+}
@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"math/big"
+	"time"
+)
+
+// OCR domain metrics, expected to be tagged with job and feed.
+
+func ReportObservationAttempted(tags Tags) {
+	increment("ocr.observation.attempted", tags)
+}
+
+func ReportObservationSucceeded(latency time.Duration, tags Tags) {
+	increment("ocr.observation.succeeded", tags)
+	timing("ocr.observation.latency", latency, tags)
+}
+
+func ReportObservationTimedOut(tags Tags) {
+	increment("ocr.observation.timed_out", tags)
+}
+
+func ReportObservationFailed(tags Tags) {
+	increment("ocr.observation.failed", tags)
+}
+
+func ReportTransmissionSent(gasUsed int64, tags Tags) {
+	increment("ocr.transmission.sent", tags)
+	gauge("ocr.transmission.gas_used", gasUsed, tags)
+}
+
+func ReportTransmissionFailed(tags Tags) {
+	increment("ocr.transmission.failed", tags)
+}
+
+// ReportTransmissionPending reports a transmission Tx accepted to mempool, but not included
+// within the transmit timeout. Its final result is reported by the confirmation tracker.
+func ReportTransmissionPending(tags Tags) {
+	increment("ocr.transmission.pending", tags)
+}
+
+// ReportTransmissionSkipped reports a transmission not broadcasted,
+// since the chain already has the same or newer report.
+func ReportTransmissionSkipped(tags Tags) {
+	increment("ocr.transmission.skipped", tags)
+}
+
+// ReportTransmissionConfirmed reports a transmission Tx included in a block,
+// along with the time passed since it was sent.
+func ReportTransmissionConfirmed(latency time.Duration, gasUsed int64, tags Tags) {
+	increment("ocr.transmission.confirmed", tags)
+	timing("ocr.transmission.confirmation_latency", latency, tags)
+	gauge("ocr.transmission.confirmed_gas_used", gasUsed, tags)
+}
+
+// ReportTransmissionReverted reports a transmission Tx included in a block, but failed in DeliverTx.
+func ReportTransmissionReverted(tags Tags) {
+	increment("ocr.transmission.reverted", tags)
+}
+
+// ReportTransmissionUnconfirmed reports a transmission Tx not included within the confirmation timeout.
+func ReportTransmissionUnconfirmed(tags Tags) {
+	increment("ocr.transmission.unconfirmed", tags)
+}
+
+// ReportLatestTransmission reports the epoch and round of the latest on-chain
+// transmission, along with seconds passed since its answer.
+func ReportLatestTransmission(epoch uint32, round uint8, answerAge time.Duration, tags Tags) {
+	gauge("ocr.latest_transmission.epoch", int64(epoch), tags)
+	gauge("ocr.latest_transmission.round", int64(round), tags)
+	gauge("ocr.latest_transmission.answer_age_seconds", answerAge.Seconds(), tags)
+}
+
+// ReportObservationDeviation reports the relative deviation between
+// the last observation and the latest on-chain answer.
+func ReportObservationDeviation(deviation float64, tags Tags) {
+	gauge("ocr.observation.deviation", deviation, tags)
+}
+
+// ReportTransmitterBalance reports the fee balance of a transmitter account in base units.
+func ReportTransmitterBalance(denom string, amount *big.Int, tags Tags) {
+	gauge("ocr.transmitter.balance", bigToFloat(amount), tags.With("denom", denom))
+}
+
+// ReportTransmitterOwed reports the amount owed to a transmitter by the OCR module in base units.
+func ReportTransmitterOwed(denom string, amount *big.Int, tags Tags) {
+	gauge("ocr.transmitter.owed", bigToFloat(amount), tags.With("denom", denom))
+}
+
+// ReportTransmitterCheckFailed reports a failed query of a transmitter balance or owed amount, tagged with query.
+func ReportTransmitterCheckFailed(query string, tags Tags) {
+	increment("ocr.transmitter.check_failed", tags.With("query", query))
+}
+
+func bigToFloat(v *big.Int) float64 {
+	if v == nil {
+		return 0
+	}
+
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
+
+func increment(name string, tags ...Tags) {
+	clientMux.RLock()
+	defer clientMux.RUnlock()
+	if client == nil {
+		return
+	}
+	client.Increment(name + joinTags(tags...))
+}
+
+func gauge(name string, value interface{}, tags ...Tags) {
+	clientMux.RLock()
+	defer clientMux.RUnlock()
+	if client == nil {
+		return
+	}
+	client.Gauge(name+joinTags(tags...), value)
+}
+
+func timing(name string, d time.Duration, tags ...Tags) {
+	clientMux.RLock()
+	defer clientMux.RUnlock()
+	if client == nil {
+		return
+	}
+	client.Timing(name+joinTags(tags...), int(d/time.Millisecond))
+}
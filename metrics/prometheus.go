@@ -0,0 +1,389 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/xlab/suplog"
+)
+
+// maxUniqueValues limits the number of unique values tracked per series by Unique.
+const maxUniqueValues = 10000
+
+// InitPrometheus sets up a Prometheus backend for all metrics reported by this package.
+// The metrics are exposed via the returned HTTP handler, that should be served on /metrics.
+func InitPrometheus(prefix string, cfg *StatterConfig) (http.Handler, error) {
+	config = checkConfig(cfg)
+
+	statter := newPrometheusStatter(prefix, config)
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(statter); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(prometheus.NewGoCollector()); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{})); err != nil {
+		return nil, err
+	}
+
+	clientMux.Lock()
+	client = statter
+	clientMux.Unlock()
+
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		ErrorLog: promErrorLogger{},
+	}), nil
+}
+
+type promErrorLogger struct{}
+
+func (promErrorLogger) Println(v ...interface{}) {
+	log.Errorln(append([]interface{}{"prometheus error:"}, v...)...)
+}
+
+type promKind int
+
+const (
+	promCounter promKind = iota
+	promGauge
+	promHistogram
+)
+
+// prometheusStatter implements Statter on top of Prometheus. Buckets are
+// formatted as "name,tag1=value1,tag2=value2", so each metric name becomes
+// a family and tags become labels. The sets of labels may differ between calls,
+// so the collector is unchecked and each family is exposed with the union of its labels,
+// the missing labels being empty. Base tags are exposed as constant labels, unless
+// a series of the family has a label of the same name, which then takes precedence.
+type prometheusStatter struct {
+	prefix      string
+	constLabels prometheus.Labels
+
+	familiesMux *sync.Mutex
+	families    map[string]*promFamily
+}
+
+var _ prometheus.Collector = &prometheusStatter{}
+
+type promFamily struct {
+	name     string
+	kind     promKind
+	bounds   []float64
+	series   map[string]*promSeries
+	warnOnce sync.Once
+}
+
+type promSeries struct {
+	labels map[string]string
+
+	value float64
+
+	count   uint64
+	sum     float64
+	buckets []uint64
+
+	uniques map[string]struct{}
+}
+
+func newPrometheusStatter(prefix string, cfg *StatterConfig) *prometheusStatter {
+	s := &prometheusStatter{
+		prefix:      strings.TrimRight(sanitizeMetricName(prefix), "_"),
+		constLabels: prometheus.Labels{},
+
+		familiesMux: new(sync.Mutex),
+		families:    make(map[string]*promFamily),
+	}
+
+	baseTags := cfg.BaseTags()
+	for i := 0; i+1 < len(baseTags); i += 2 {
+		s.constLabels[sanitizeMetricName(baseTags[i])] = baseTags[i+1]
+	}
+
+	return s
+}
+
+func (s *prometheusStatter) Count(bucket string, n interface{}) {
+	s.update(bucket, "_total", promCounter, nil, func(series *promSeries) {
+		series.value += toFloat64(n)
+	})
+}
+
+func (s *prometheusStatter) Increment(bucket string) {
+	s.update(bucket, "_total", promCounter, nil, func(series *promSeries) {
+		series.value++
+	})
+}
+
+func (s *prometheusStatter) Gauge(bucket string, value interface{}) {
+	s.update(bucket, "", promGauge, nil, func(series *promSeries) {
+		series.value = toFloat64(value)
+	})
+}
+
+// Timing observes the value in milliseconds, exposed in seconds.
+func (s *prometheusStatter) Timing(bucket string, value interface{}) {
+	s.update(bucket, "_seconds", promHistogram, prometheus.DefBuckets, func(series *promSeries) {
+		series.observe(toFloat64(value)/1000, prometheus.DefBuckets)
+	})
+}
+
+func (s *prometheusStatter) Histogram(bucket string, value interface{}) {
+	s.update(bucket, "", promHistogram, prometheus.DefBuckets, func(series *promSeries) {
+		series.observe(toFloat64(value), prometheus.DefBuckets)
+	})
+}
+
+// Unique counts the number of unique values observed, exposed as a gauge.
+func (s *prometheusStatter) Unique(bucket string, value string) {
+	s.update(bucket, "_unique", promGauge, nil, func(series *promSeries) {
+		if series.uniques == nil {
+			series.uniques = make(map[string]struct{})
+		}
+
+		if len(series.uniques) < maxUniqueValues {
+			series.uniques[value] = struct{}{}
+		}
+
+		series.value = float64(len(series.uniques))
+	})
+}
+
+func (s *prometheusStatter) Close() {}
+
+func (s *prometheusStatter) update(
+	bucket string,
+	suffix string,
+	kind promKind,
+	bounds []float64,
+	updateFn func(series *promSeries),
+) {
+	name, labels := parseBucket(bucket)
+	if len(name) == 0 {
+		return
+	}
+
+	fqName := name + suffix
+	if len(s.prefix) > 0 {
+		fqName = s.prefix + "_" + fqName
+	}
+
+	s.familiesMux.Lock()
+	defer s.familiesMux.Unlock()
+
+	family, ok := s.families[fqName]
+	if !ok {
+		family = &promFamily{
+			name:   fqName,
+			kind:   kind,
+			bounds: bounds,
+			series: make(map[string]*promSeries),
+		}
+
+		s.families[fqName] = family
+	} else if family.kind != kind {
+		family.warnOnce.Do(func() {
+			log.WithField("metric", fqName).Warningln("metric reported with different types, ignoring")
+		})
+
+		return
+	}
+
+	seriesKey := labelsKey(labels)
+	series, ok := family.series[seriesKey]
+	if !ok {
+		series = &promSeries{
+			labels: labels,
+		}
+
+		if kind == promHistogram {
+			series.buckets = make([]uint64, len(bounds))
+		}
+
+		family.series[seriesKey] = series
+	}
+
+	updateFn(series)
+}
+
+func (s *promSeries) observe(v float64, bounds []float64) {
+	s.count++
+	s.sum += v
+
+	for idx, bound := range bounds {
+		if v <= bound {
+			s.buckets[idx]++
+			break
+		}
+	}
+}
+
+// Describe sends no descriptors, making the collector unchecked.
+func (s *prometheusStatter) Describe(ch chan<- *prometheus.Desc) {}
+
+func (s *prometheusStatter) Collect(ch chan<- prometheus.Metric) {
+	s.familiesMux.Lock()
+	defer s.familiesMux.Unlock()
+
+	for _, family := range s.families {
+		labelNames := family.labelNames()
+
+		// base tags colliding with series labels would make the desc invalid,
+		// so they become default values of the series labels instead.
+		constLabels := make(prometheus.Labels, len(s.constLabels))
+		for name, value := range s.constLabels {
+			constLabels[name] = value
+		}
+		for _, labelName := range labelNames {
+			delete(constLabels, labelName)
+		}
+
+		desc := prometheus.NewDesc(family.name, family.name, labelNames, constLabels)
+
+		for _, series := range family.series {
+			labelValues := make([]string, 0, len(labelNames))
+			for _, labelName := range labelNames {
+				labelValue, ok := series.labels[labelName]
+				if !ok {
+					labelValue = s.constLabels[labelName]
+				}
+
+				labelValues = append(labelValues, labelValue)
+			}
+
+			var metric prometheus.Metric
+			var err error
+
+			switch family.kind {
+			case promCounter:
+				metric, err = prometheus.NewConstMetric(desc, prometheus.CounterValue, series.value, labelValues...)
+			case promGauge:
+				metric, err = prometheus.NewConstMetric(desc, prometheus.GaugeValue, series.value, labelValues...)
+			case promHistogram:
+				buckets := make(map[float64]uint64, len(family.bounds))
+
+				var cumulative uint64
+				for idx, bound := range family.bounds {
+					cumulative += series.buckets[idx]
+					buckets[bound] = cumulative
+				}
+
+				metric, err = prometheus.NewConstHistogram(desc, series.count, series.sum, buckets, labelValues...)
+			}
+
+			if err != nil {
+				log.WithError(err).WithField("metric", family.name).Warningln("failed to collect metric")
+				continue
+			}
+
+			ch <- metric
+		}
+	}
+}
+
+// labelNames returns the sorted union of label names of all series.
+func (f *promFamily) labelNames() []string {
+	names := make(map[string]struct{})
+	for _, series := range f.series {
+		for name := range series.labels {
+			names[name] = struct{}{}
+		}
+	}
+
+	labelNames := make([]string, 0, len(names))
+	for name := range names {
+		labelNames = append(labelNames, name)
+	}
+	sort.Strings(labelNames)
+
+	return labelNames
+}
+
+// parseBucket splits the bucket into metric name and labels, omitting the empty ones.
+func parseBucket(bucket string) (name string, labels map[string]string) {
+	parts := strings.Split(bucket, ",")
+	name = sanitizeMetricName(parts[0])
+	labels = make(map[string]string, len(parts)-1)
+
+	for _, part := range parts[1:] {
+		idx := strings.IndexByte(part, '=')
+		if idx <= 0 || idx == len(part)-1 {
+			continue
+		}
+
+		labels[sanitizeMetricName(part[:idx])] = part[idx+1:]
+	}
+
+	return name, labels
+}
+
+func labelsKey(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var key strings.Builder
+	for _, name := range names {
+		key.WriteString(name)
+		key.WriteByte('=')
+		key.WriteString(labels[name])
+		key.WriteByte(',')
+	}
+
+	return key.String()
+}
+
+// sanitizeMetricName replaces all chars not allowed in Prometheus names with underscores.
+func sanitizeMetricName(name string) string {
+	if len(name) > 0 && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+func toFloat64(v interface{}) float64 {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint:
+		return float64(v)
+	case uint32:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	case time.Duration:
+		return float64(v / time.Millisecond)
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	default:
+		f, _ := strconv.ParseFloat(fmt.Sprint(v), 64)
+		return f
+	}
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("prometheusStatter", func() {
+	DescribeTable("parses bucket names",
+		func(bucket, expectedName string, expectedLabels map[string]string) {
+			name, labels := parseBucket(bucket)
+			Expect(name).To(Equal(expectedName))
+			Expect(labels).To(Equal(expectedLabels))
+		},
+		Entry("name only", "tx_sent", "tx_sent", map[string]string{}),
+		Entry("name and tags", "tx_sent,svc=tx,feedId=LINK/USDT", "tx_sent", map[string]string{
+			"svc":    "tx",
+			"feedId": "LINK/USDT",
+		}),
+		Entry("invalid chars", "ds.observe,source-name=coingecko", "ds_observe", map[string]string{
+			"source_name": "coingecko",
+		}),
+		Entry("leading digit", "1st_call,k=v", "_1st_call", map[string]string{
+			"k": "v",
+		}),
+		Entry("value with equal sign", "tx_sent,k=a=b", "tx_sent", map[string]string{
+			"k": "a=b",
+		}),
+		Entry("empty and malformed tags", "tx_sent,,=v,k=,broken", "tx_sent", map[string]string{}),
+		Entry("empty bucket", "", "", map[string]string{}),
+	)
+
+	DescribeTable("exposes the union of series labels",
+		func(buckets []string, expectedNames []string) {
+			s := newPrometheusStatter("", &StatterConfig{})
+			for _, bucket := range buckets {
+				s.Increment(bucket)
+			}
+
+			Expect(s.families).To(HaveKey("calls_total"))
+			Expect(s.families["calls_total"].labelNames()).To(Equal(expectedNames))
+		},
+		Entry("no labels", []string{"calls"}, []string{}),
+		Entry("same labels", []string{"calls,svc=a", "calls,svc=b"}, []string{"svc"}),
+		Entry("different labels", []string{"calls,svc=a", "calls,feedId=x", "calls,svc=b,job=1"}, []string{"feedId", "job", "svc"}),
+	)
+
+	It("is gathered through a registry", func() {
+		s := newPrometheusStatter("oracle", &StatterConfig{
+			EnvName:  "test",
+			HostName: "host1",
+		})
+
+		registry := prometheus.NewRegistry()
+		Expect(registry.Register(s)).To(BeNil())
+
+		s.Increment("tx_sent,svc=tx")
+		s.Count("tx_sent,svc=tx,feedId=LINK/USDT", 2)
+		s.Gauge("balance,svc=balance_monitor,env=staging", 5)
+		s.Gauge("balance,svc=balance_monitor", 7)
+		s.Unique("peers,svc=p2p", "a")
+		s.Unique("peers,svc=p2p", "b")
+		s.Unique("peers,svc=p2p", "a")
+
+		// reported with a different type, ignored
+		s.Gauge("tx_sent_total,svc=tx", 100)
+
+		expected := `
+# HELP oracle_balance oracle_balance
+# TYPE oracle_balance gauge
+oracle_balance{env="staging",machine="host1",svc="balance_monitor"} 5
+oracle_balance{env="test",machine="host1",svc="balance_monitor"} 7
+# HELP oracle_peers_unique oracle_peers_unique
+# TYPE oracle_peers_unique gauge
+oracle_peers_unique{env="test",machine="host1",svc="p2p"} 2
+# HELP oracle_tx_sent_total oracle_tx_sent_total
+# TYPE oracle_tx_sent_total counter
+oracle_tx_sent_total{env="test",feedId="",machine="host1",svc="tx"} 1
+oracle_tx_sent_total{env="test",feedId="LINK/USDT",machine="host1",svc="tx"} 2
+`
+
+		err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+			"oracle_balance", "oracle_peers_unique", "oracle_tx_sent_total")
+		Expect(err).To(BeNil())
+	})
+
+	It("exposes timings in seconds", func() {
+		s := newPrometheusStatter("", &StatterConfig{})
+
+		registry := prometheus.NewRegistry()
+		Expect(registry.Register(s)).To(BeNil())
+
+		s.Timing("ds_observe,source=coingecko", 1500)
+		s.Timing("ds_observe,source=coingecko", 20)
+
+		families, err := registry.Gather()
+		Expect(err).To(BeNil())
+		Expect(families).To(HaveLen(1))
+		Expect(families[0].GetName()).To(Equal("ds_observe_seconds"))
+
+		histogram := families[0].GetMetric()[0].GetHistogram()
+		Expect(histogram.GetSampleCount()).To(Equal(uint64(2)))
+		Expect(histogram.GetSampleSum()).To(BeNumerically("~", 1.52, 1e-9))
+	})
+})
@@ -0,0 +1,30 @@
+package metrics
+
+// Cosmos Tx broadcasting metrics, expected to be tagged with svc.
+
+// ReportTxRetried reports a broadcast attempt that has been retried,
+// the reason is one of: sequence_mismatch, insufficient_fee, mempool_full, out_of_gas, unavailable.
+func ReportTxRetried(reason string, tags Tags) {
+	increment("tx.retried", tags.With("reason", reason))
+}
+
+func ReportTxFeeBumped(tags Tags) {
+	increment("tx.fee_bumped", tags)
+}
+
+func ReportTxSequenceResynced(tags Tags) {
+	increment("tx.sequence_resynced", tags)
+}
+
+func ReportTxSimulatedGas(gas uint64, tags Tags) {
+	gauge("tx.simulated_gas", int64(gas), tags)
+}
+
+func ReportTxBatchSize(numMsgs int, tags Tags) {
+	gauge("tx.batch_size", int64(numMsgs), tags)
+}
+
+// ReportTxBatchFallback reports a failed batched Tx, which msgs are broadcasted separately.
+func ReportTxBatchFallback(tags Tags) {
+	increment("tx.batch_fallback", tags)
+}
@@ -0,0 +1,282 @@
+package config
+
+import (
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	yaml "gopkg.in/yaml.v2"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+// OffchainConfigVersion is the version of offchain config encoding used by OCR2 feeds.
+const OffchainConfigVersion = 2
+
+// FeedConfigSpec is a human-readable description of the feed config,
+// loaded from YAML or JSON. Durations are specified as strings, e.g. "5s".
+//
+// See https://research.chain.link/ocr.pdf for the reference on protocol variables.
+type FeedConfigSpec struct {
+	FeedID      string       `yaml:"feedId"`
+	Description string       `yaml:"description"`
+	F           uint32       `yaml:"f"`
+	Oracles     []OracleSpec `yaml:"oracles"`
+
+	DeltaProgress time.Duration `yaml:"deltaProgress"`
+	DeltaResend   time.Duration `yaml:"deltaResend"`
+	DeltaRound    time.Duration `yaml:"deltaRound"`
+	DeltaGrace    time.Duration `yaml:"deltaGrace"`
+	DeltaStage    time.Duration `yaml:"deltaStage"`
+	RMax          uint32        `yaml:"rMax"`
+	// S is the transmission schedule, defaults to one oracle per stage.
+	S []uint32 `yaml:"s"`
+
+	MaxDurationQuery                        time.Duration `yaml:"maxDurationQuery"`
+	MaxDurationObservation                  time.Duration `yaml:"maxDurationObservation"`
+	MaxDurationReport                       time.Duration `yaml:"maxDurationReport"`
+	MaxDurationShouldAcceptFinalizedReport  time.Duration `yaml:"maxDurationShouldAcceptFinalizedReport"`
+	MaxDurationShouldTransmitAcceptedReport time.Duration `yaml:"maxDurationShouldTransmitAcceptedReport"`
+
+	Median  MedianSpec  `yaml:"median"`
+	Billing BillingSpec `yaml:"billing"`
+}
+
+// OracleSpec describes keys of a single oracle, keys are hex-encoded.
+type OracleSpec struct {
+	Transmitter         string `yaml:"transmitter"`
+	Signer              string `yaml:"signer"`
+	OffchainPublicKey   string `yaml:"offchainPublicKey"`
+	ConfigEncryptionKey string `yaml:"configEncryptionKey"`
+	PeerID              string `yaml:"peerId"`
+}
+
+// MedianSpec holds parameters of the median reporting plugin.
+// MinAnswer and MaxAnswer are decimals, e.g. "0.000000000000000001".
+type MedianSpec struct {
+	AlphaReportPPB uint64        `yaml:"alphaReportPPB"`
+	AlphaAcceptPPB uint64        `yaml:"alphaAcceptPPB"`
+	DeltaC         time.Duration `yaml:"deltaC"`
+	MinAnswer      string        `yaml:"minAnswer"`
+	MaxAnswer      string        `yaml:"maxAnswer"`
+}
+
+// BillingSpec holds the module params related to payouts and feed administration.
+type BillingSpec struct {
+	LinkPerObservation  string `yaml:"linkPerObservation"`
+	LinkPerTransmission string `yaml:"linkPerTransmission"`
+	LinkDenom           string `yaml:"linkDenom"`
+	UniqueReports       bool   `yaml:"uniqueReports"`
+	FeedAdmin           string `yaml:"feedAdmin"`
+	BillingAdmin        string `yaml:"billingAdmin"`
+}
+
+// LoadFeedConfigSpec reads the spec from a YAML or JSON file.
+func LoadFeedConfigSpec(path string) (*FeedConfigSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		err = errors.Wrap(err, "failed to read feed config spec")
+		return nil, err
+	}
+
+	var spec FeedConfigSpec
+	if err := yaml.UnmarshalStrict(data, &spec); err != nil {
+		err = errors.Wrapf(err, "failed to parse feed config spec from %s", path)
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// BuildFeedConfig produces the FeedConfig described by spec. A fresh shared secret
+// is generated using rand and encrypted for each oracle's config encryption key.
+// The resulting config is checked with FeedConfig.ValidateBasic.
+func BuildFeedConfig(spec *FeedConfigSpec, rand io.Reader) (*chaintypes.FeedConfig, error) {
+	if len(spec.Oracles) == 0 {
+		err := errors.New("no oracles specified")
+		return nil, err
+	}
+
+	minAnswer, err := sdk.NewDecFromStr(spec.Median.MinAnswer)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse median.minAnswer")
+		return nil, err
+	}
+
+	maxAnswer, err := sdk.NewDecFromStr(spec.Median.MaxAnswer)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse median.maxAnswer")
+		return nil, err
+	}
+
+	linkPerObservation, ok := sdk.NewIntFromString(spec.Billing.LinkPerObservation)
+	if !ok {
+		err := errors.Errorf("failed to parse billing.linkPerObservation: %s", spec.Billing.LinkPerObservation)
+		return nil, err
+	}
+
+	linkPerTransmission, ok := sdk.NewIntFromString(spec.Billing.LinkPerTransmission)
+	if !ok {
+		err := errors.Errorf("failed to parse billing.linkPerTransmission: %s", spec.Billing.LinkPerTransmission)
+		return nil, err
+	}
+
+	onchainConfig, err := (&median.OnchainConfig{
+		Min: minAnswer.BigInt(),
+		Max: maxAnswer.BigInt(),
+	}).Encode()
+	if err != nil {
+		err = errors.Wrap(err, "failed to encode median onchain config")
+		return nil, err
+	}
+
+	offchainConfig, err := buildOffchainConfig(spec, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	offchainConfigBytes, err := offchainConfig.Encode()
+	if err != nil {
+		err = errors.Wrap(err, "failed to encode offchain config")
+		return nil, err
+	}
+
+	feedConfig := &chaintypes.FeedConfig{
+		Signers:               make([]string, 0, len(spec.Oracles)),
+		Transmitters:          make([]string, 0, len(spec.Oracles)),
+		F:                     spec.F,
+		OnchainConfig:         onchainConfig,
+		OffchainConfigVersion: OffchainConfigVersion,
+		OffchainConfig:        offchainConfigBytes,
+		ModuleParams: &chaintypes.ModuleParams{
+			FeedId:              spec.FeedID,
+			MinAnswer:           minAnswer,
+			MaxAnswer:           maxAnswer,
+			LinkPerObservation:  linkPerObservation,
+			LinkPerTransmission: linkPerTransmission,
+			LinkDenom:           spec.Billing.LinkDenom,
+			UniqueReports:       spec.Billing.UniqueReports,
+			Description:         spec.Description,
+			FeedAdmin:           spec.Billing.FeedAdmin,
+			BillingAdmin:        spec.Billing.BillingAdmin,
+		},
+	}
+
+	for _, oracle := range spec.Oracles {
+		feedConfig.Signers = append(feedConfig.Signers, oracle.Signer)
+		feedConfig.Transmitters = append(feedConfig.Transmitters, oracle.Transmitter)
+	}
+
+	if err := feedConfig.ValidateBasic(); err != nil {
+		err = errors.Wrap(err, "feed config is invalid")
+		return nil, err
+	}
+
+	return feedConfig, nil
+}
+
+func buildOffchainConfig(spec *FeedConfigSpec, rand io.Reader) (*OffchainConfig, error) {
+	maxDurationsSum := spec.MaxDurationQuery + spec.MaxDurationObservation + spec.MaxDurationReport
+	if maxDurationsSum >= spec.DeltaProgress {
+		err := errors.Errorf(
+			"sum of maxDurationQuery/Observation/Report (%s) must be less than deltaProgress (%s)",
+			maxDurationsSum, spec.DeltaProgress,
+		)
+		return nil, err
+	}
+
+	s := spec.S
+	if len(s) == 0 {
+		s = make([]uint32, len(spec.Oracles))
+		for i := range s {
+			s[i] = 1
+		}
+	}
+
+	offchainPublicKeys := make([][]byte, 0, len(spec.Oracles))
+	encryptionKeys := make([]ocrtypes.SharedSecretEncryptionPublicKey, 0, len(spec.Oracles))
+	peerIDs := make([]string, 0, len(spec.Oracles))
+
+	for i, oracle := range spec.Oracles {
+		offchainPublicKey, err := decodeHexKey(oracle.OffchainPublicKey)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to decode offchainPublicKey of oracle %d", i)
+			return nil, err
+		}
+
+		encryptionKey, err := decodeHexKey(oracle.ConfigEncryptionKey)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to decode configEncryptionKey of oracle %d", i)
+			return nil, err
+		}
+
+		if len(oracle.PeerID) == 0 {
+			err := errors.Errorf("peerId of oracle %d is not specified", i)
+			return nil, err
+		}
+
+		var encryptionPublicKey ocrtypes.SharedSecretEncryptionPublicKey
+		copy(encryptionPublicKey[:], encryptionKey)
+
+		offchainPublicKeys = append(offchainPublicKeys, offchainPublicKey)
+		encryptionKeys = append(encryptionKeys, encryptionPublicKey)
+		peerIDs = append(peerIDs, oracle.PeerID)
+	}
+
+	var sharedSecret [SharedSecretSize]byte
+	if _, err := io.ReadFull(rand, sharedSecret[:]); err != nil {
+		err = errors.Wrap(err, "failed to generate shared secret")
+		return nil, err
+	}
+
+	sharedSecretEncryptions := EncryptSharedSecret(encryptionKeys, &sharedSecret, rand)
+
+	medianPluginConfig := &median.OffchainConfig{
+		AlphaReportPPB: spec.Median.AlphaReportPPB,
+		AlphaAcceptPPB: spec.Median.AlphaAcceptPPB,
+		DeltaC:         spec.Median.DeltaC,
+	}
+
+	config := &OffchainConfig{
+		DeltaProgress:      uint64(spec.DeltaProgress),
+		DeltaResend:        uint64(spec.DeltaResend),
+		DeltaRound:         uint64(spec.DeltaRound),
+		DeltaGrace:         uint64(spec.DeltaGrace),
+		DeltaStage:         uint64(spec.DeltaStage),
+		RMax:               spec.RMax,
+		S:                  s,
+		OffchainPublicKeys: offchainPublicKeys,
+		PeerIds:            peerIDs,
+
+		ReportingPluginConfig: medianPluginConfig.Encode(),
+
+		MaxDurationQuery:       uint64(spec.MaxDurationQuery),
+		MaxDurationObservation: uint64(spec.MaxDurationObservation),
+		MaxDurationReport:      uint64(spec.MaxDurationReport),
+
+		MaxDurationShouldAcceptFinalizedReport:  uint64(spec.MaxDurationShouldAcceptFinalizedReport),
+		MaxDurationShouldTransmitAcceptedReport: uint64(spec.MaxDurationShouldTransmitAcceptedReport),
+
+		SharedSecretEncryptions: sharedSecretEncryptions.Proto(),
+	}
+
+	return config, nil
+}
+
+func decodeHexKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	} else if len(key) != 32 {
+		err = errors.Errorf("expected 32 bytes, got %d", len(key))
+		return nil, err
+	}
+
+	return key, nil
+}
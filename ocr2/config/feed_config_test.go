@@ -0,0 +1,192 @@
+package config
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/InjectiveLabs/chainlink-injective/keys/ocrkey"
+)
+
+var _ = Describe("Feed config", func() {
+	var (
+		spec  *FeedConfigSpec
+		local []ocrkey.OCR2KeyWrapper
+	)
+
+	BeforeEach(func() {
+		spec, local = newTestFeedConfigSpec(4)
+	})
+
+	It("is decoded back by InspectFeedConfig", func() {
+		feedConfig, err := BuildFeedConfig(spec, rand.Reader)
+		Expect(err).To(BeNil())
+
+		info, err := InspectFeedConfig(feedConfig, nil)
+		Expect(err).To(BeNil())
+		Expect(info.Membership).To(BeNil())
+
+		Expect(info.FeedID).To(Equal("LINK/USDC"))
+		Expect(info.F).To(Equal(uint32(1)))
+		Expect(info.OffchainConfigVersion).To(Equal(uint64(OffchainConfigVersion)))
+		Expect(info.ModuleParams.Description).To(Equal("LINK/USDC test feed"))
+		Expect(info.ModuleParams.LinkPerTransmission.String()).To(Equal("20"))
+
+		for i, oracle := range spec.Oracles {
+			Expect(info.Signers[i]).To(Equal(oracle.Signer))
+			Expect(info.Transmitters[i]).To(Equal(oracle.Transmitter))
+			Expect(info.OffchainConfig.OffchainPublicKeys[i]).To(Equal(oracle.OffchainPublicKey))
+			Expect(info.OffchainConfig.PeerIDs[i]).To(Equal(oracle.PeerID))
+		}
+
+		// answers are scaled by the sdk.Dec precision
+		Expect(info.OnchainConfig).To(Equal(&MedianOnchainConfigInfo{
+			Min: "1000000000000",
+			Max: "1000000000000000000000000",
+		}))
+
+		Expect(info.OffchainConfig.DeltaProgress).To(Equal("10s"))
+		Expect(info.OffchainConfig.DeltaResend).To(Equal("5s"))
+		Expect(info.OffchainConfig.DeltaRound).To(Equal("3s"))
+		Expect(info.OffchainConfig.DeltaGrace).To(Equal("1s"))
+		Expect(info.OffchainConfig.DeltaStage).To(Equal("5s"))
+		Expect(info.OffchainConfig.RMax).To(Equal(uint32(10)))
+		Expect(info.OffchainConfig.S).To(Equal([]uint32{1, 1, 1, 1}))
+		Expect(info.OffchainConfig.MaxDurationQuery).To(Equal("1s"))
+		Expect(info.OffchainConfig.MaxDurationShouldTransmitAcceptedReport).To(Equal("2s"))
+		Expect(info.OffchainConfig.ReportingPluginConfig).To(Equal(&MedianPluginConfigInfo{
+			AlphaReportPPB: 1000000,
+			AlphaAcceptPPB: 2000000,
+			DeltaC:         "1m0s",
+		}))
+		Expect(info.OffchainConfig.SharedSecretEncryptions).To(Equal(4))
+	})
+
+	It("encrypts the shared secret for each oracle", func() {
+		// the shared secret is read first from the randomness source
+		entropy := make([]byte, SharedSecretSize+32)
+		_, err := rand.Read(entropy)
+		Expect(err).To(BeNil())
+
+		feedConfig, err := BuildFeedConfig(spec, bytes.NewReader(entropy))
+		Expect(err).To(BeNil())
+
+		offchainConfig, err := DecodeConfig(feedConfig.OffchainConfig)
+		Expect(err).To(BeNil())
+
+		encryptions, err := SharedSecretEncryptionsFromProto(offchainConfig.SharedSecretEncryptions)
+		Expect(err).To(BeNil())
+
+		for i, keyring := range local {
+			sharedSecret, err := encryptions.Decrypt(commontypes.OracleID(i), keyring)
+			Expect(err).To(BeNil())
+			Expect(sharedSecret[:]).To(Equal(entropy[:SharedSecretSize]))
+		}
+
+		// an oracle can't decrypt the secret of another one
+		_, err = encryptions.Decrypt(commontypes.OracleID(1), local[0])
+		Expect(err).ToNot(BeNil())
+
+		info, err := InspectFeedConfig(feedConfig, &LocalOracle{
+			OffchainKeyring: local[2],
+		})
+		Expect(err).To(BeNil())
+		Expect(info.OffchainConfig.SharedSecretHash).To(Equal(encryptions.SharedSecretHash.Hex()))
+		Expect(info.Membership.OffchainPublicKeyIndex).To(Equal(2))
+		Expect(info.Membership.SharedSecretDecrypted).To(BeTrue())
+	})
+
+	It("rejects invalid specs", func() {
+		spec.DeltaProgress = 3 * time.Second
+		_, err := BuildFeedConfig(spec, rand.Reader)
+		Expect(err).ToNot(BeNil())
+
+		spec, _ = newTestFeedConfigSpec(4)
+		spec.Oracles[1].ConfigEncryptionKey = "0x1234"
+		_, err = BuildFeedConfig(spec, rand.Reader)
+		Expect(err).ToNot(BeNil())
+
+		spec, _ = newTestFeedConfigSpec(4)
+		spec.Oracles[2].PeerID = ""
+		_, err = BuildFeedConfig(spec, rand.Reader)
+		Expect(err).ToNot(BeNil())
+
+		spec, _ = newTestFeedConfigSpec(4)
+		spec.Median.MinAnswer = "x"
+		_, err = BuildFeedConfig(spec, rand.Reader)
+		Expect(err).ToNot(BeNil())
+
+		// 3f must be less than the number of oracles
+		spec, _ = newTestFeedConfigSpec(3)
+		_, err = BuildFeedConfig(spec, rand.Reader)
+		Expect(err).ToNot(BeNil())
+
+		_, err = BuildFeedConfig(&FeedConfigSpec{}, rand.Reader)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+// newTestFeedConfigSpec returns a valid spec with n oracles, along with their OCR keys.
+func newTestFeedConfigSpec(n int) (*FeedConfigSpec, []ocrkey.OCR2KeyWrapper) {
+	spec := &FeedConfigSpec{
+		FeedID:      "LINK/USDC",
+		Description: "LINK/USDC test feed",
+		F:           1,
+
+		DeltaProgress: 10 * time.Second,
+		DeltaResend:   5 * time.Second,
+		DeltaRound:    3 * time.Second,
+		DeltaGrace:    time.Second,
+		DeltaStage:    5 * time.Second,
+		RMax:          10,
+
+		MaxDurationQuery:                        time.Second,
+		MaxDurationObservation:                  time.Second,
+		MaxDurationReport:                       time.Second,
+		MaxDurationShouldAcceptFinalizedReport:  time.Second,
+		MaxDurationShouldTransmitAcceptedReport: 2 * time.Second,
+
+		Median: MedianSpec{
+			AlphaReportPPB: 1000000,
+			AlphaAcceptPPB: 2000000,
+			DeltaC:         time.Minute,
+			MinAnswer:      "0.000001",
+			MaxAnswer:      "1000000",
+		},
+		Billing: BillingSpec{
+			LinkPerObservation:  "10",
+			LinkPerTransmission: "20",
+			LinkDenom:           "link",
+		},
+	}
+
+	keyrings := make([]ocrkey.OCR2KeyWrapper, 0, n)
+
+	for i := 0; i < n; i++ {
+		key, err := ocrkey.NewV2()
+		Expect(err).To(BeNil())
+
+		keyring := ocrkey.NewOCR2KeyWrapper(key)
+		keyrings = append(keyrings, keyring)
+
+		offchainPublicKey := keyring.OffchainPublicKey()
+		encryptionKey := keyring.ConfigEncryptionPublicKey()
+
+		spec.Oracles = append(spec.Oracles, OracleSpec{
+			Signer:              sdk.AccAddress(fmt.Sprintf("signer_%013d", i)).String(),
+			Transmitter:         sdk.AccAddress(fmt.Sprintf("transmitter_%08d", i)).String(),
+			OffchainPublicKey:   hex.EncodeToString(offchainPublicKey[:]),
+			ConfigEncryptionKey: "0x" + hex.EncodeToString(encryptionKey[:]),
+			PeerID:              fmt.Sprintf("peer-%d", i),
+		})
+	}
+
+	return spec, keyrings
+}
@@ -0,0 +1,299 @@
+package config
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+// FeedConfigInfo is a human-readable form of the on-chain FeedConfig,
+// with both onchain and offchain configs decoded.
+type FeedConfigInfo struct {
+	FeedID                string                   `json:"feedId"`
+	Signers               []string                 `json:"signers"`
+	Transmitters          []string                 `json:"transmitters"`
+	F                     uint32                   `json:"f"`
+	OnchainConfig         *MedianOnchainConfigInfo `json:"onchainConfig"`
+	OffchainConfigVersion uint64                   `json:"offchainConfigVersion"`
+	OffchainConfig        *OffchainConfigInfo      `json:"offchainConfig"`
+	ModuleParams          *chaintypes.ModuleParams `json:"moduleParams"`
+
+	// Membership is set only when the local oracle identity is provided.
+	Membership *Membership `json:"membership,omitempty"`
+}
+
+type MedianOnchainConfigInfo struct {
+	Min string `json:"min"`
+	Max string `json:"max"`
+}
+
+type OffchainConfigInfo struct {
+	DeltaProgress string   `json:"deltaProgress"`
+	DeltaResend   string   `json:"deltaResend"`
+	DeltaRound    string   `json:"deltaRound"`
+	DeltaGrace    string   `json:"deltaGrace"`
+	DeltaStage    string   `json:"deltaStage"`
+	RMax          uint32   `json:"rMax"`
+	S             []uint32 `json:"s"`
+
+	OffchainPublicKeys []string `json:"offchainPublicKeys"`
+	PeerIDs            []string `json:"peerIds"`
+
+	ReportingPluginConfig *MedianPluginConfigInfo `json:"reportingPluginConfig"`
+
+	MaxDurationQuery                        string `json:"maxDurationQuery"`
+	MaxDurationObservation                  string `json:"maxDurationObservation"`
+	MaxDurationReport                       string `json:"maxDurationReport"`
+	MaxDurationShouldAcceptFinalizedReport  string `json:"maxDurationShouldAcceptFinalizedReport"`
+	MaxDurationShouldTransmitAcceptedReport string `json:"maxDurationShouldTransmitAcceptedReport"`
+
+	SharedSecretHash        string `json:"sharedSecretHash"`
+	SharedSecretEncryptions int    `json:"sharedSecretEncryptions"`
+}
+
+type MedianPluginConfigInfo struct {
+	AlphaReportPPB uint64 `json:"alphaReportPPB"`
+	AlphaAcceptPPB uint64 `json:"alphaAcceptPPB"`
+	DeltaC         string `json:"deltaC"`
+}
+
+// LocalOracle is the identity of this node, checked against the feed config.
+// Empty fields are not checked.
+type LocalOracle struct {
+	Signer string
+	// Transmitters lists all transmitter accounts of the node, any of them may be used for the feed.
+	Transmitters    []string
+	PeerID          string
+	OffchainKeyring ocrtypes.OffchainKeyring
+}
+
+// Membership describes where identities of the local oracle are found in the feed config.
+// Indexes are -1 when the identity is missing or not checked.
+type Membership struct {
+	OracleIndex            int  `json:"oracleIndex"`
+	SignerIndex            int  `json:"signerIndex"`
+	TransmitterIndex       int  `json:"transmitterIndex"`
+	OffchainPublicKeyIndex int  `json:"offchainPublicKeyIndex"`
+	PeerIDIndex            int  `json:"peerIdIndex"`
+	SharedSecretDecrypted  bool `json:"sharedSecretDecrypted"`
+
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Verify returns an error describing all inconsistencies, if the local oracle
+// is not a valid member of the feed.
+func (m *Membership) Verify() error {
+	if len(m.Warnings) > 0 {
+		return errors.Errorf("not a valid member of the feed: %s", strings.Join(m.Warnings, "; "))
+	} else if m.OracleIndex < 0 {
+		return errors.New("not a valid member of the feed: no local identities checked")
+	}
+
+	return nil
+}
+
+func (m *Membership) warnf(format string, args ...interface{}) {
+	m.Warnings = append(m.Warnings, fmt.Sprintf(format, args...))
+}
+
+// InspectFeedConfig decodes the feed config. If local is set, it also checks membership
+// of the local oracle and tries to decrypt the shared secret with its offchain keyring.
+func InspectFeedConfig(feedConfig *chaintypes.FeedConfig, local *LocalOracle) (*FeedConfigInfo, error) {
+	if feedConfig == nil {
+		return nil, errors.New("feed config is missing")
+	}
+
+	info := &FeedConfigInfo{
+		Signers:               feedConfig.Signers,
+		Transmitters:          feedConfig.Transmitters,
+		F:                     feedConfig.F,
+		OffchainConfigVersion: feedConfig.OffchainConfigVersion,
+		ModuleParams:          feedConfig.ModuleParams,
+	}
+
+	if feedConfig.ModuleParams != nil {
+		info.FeedID = feedConfig.ModuleParams.FeedId
+	}
+
+	onchainConfig, err := median.DecodeOnchainConfig(feedConfig.OnchainConfig)
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode median onchain config")
+		return nil, err
+	}
+
+	info.OnchainConfig = &MedianOnchainConfigInfo{
+		Min: onchainConfig.Min.String(),
+		Max: onchainConfig.Max.String(),
+	}
+
+	offchainConfig, err := DecodeConfig(feedConfig.OffchainConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	medianConfig, err := median.DecodeOffchainConfig(offchainConfig.ReportingPluginConfig)
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode median reporting plugin config")
+		return nil, err
+	}
+
+	sharedSecretEncryptions, err := SharedSecretEncryptionsFromProto(offchainConfig.SharedSecretEncryptions)
+	if err != nil {
+		err = errors.Wrap(err, "failed to decode shared secret encryptions")
+		return nil, err
+	}
+
+	info.OffchainConfig = &OffchainConfigInfo{
+		DeltaProgress:      time.Duration(offchainConfig.DeltaProgress).String(),
+		DeltaResend:        time.Duration(offchainConfig.DeltaResend).String(),
+		DeltaRound:         time.Duration(offchainConfig.DeltaRound).String(),
+		DeltaGrace:         time.Duration(offchainConfig.DeltaGrace).String(),
+		DeltaStage:         time.Duration(offchainConfig.DeltaStage).String(),
+		RMax:               offchainConfig.RMax,
+		S:                  offchainConfig.S,
+		OffchainPublicKeys: make([]string, 0, len(offchainConfig.OffchainPublicKeys)),
+		PeerIDs:            offchainConfig.PeerIds,
+
+		ReportingPluginConfig: &MedianPluginConfigInfo{
+			AlphaReportPPB: medianConfig.AlphaReportPPB,
+			AlphaAcceptPPB: medianConfig.AlphaAcceptPPB,
+			DeltaC:         medianConfig.DeltaC.String(),
+		},
+
+		MaxDurationQuery:                        time.Duration(offchainConfig.MaxDurationQuery).String(),
+		MaxDurationObservation:                  time.Duration(offchainConfig.MaxDurationObservation).String(),
+		MaxDurationReport:                       time.Duration(offchainConfig.MaxDurationReport).String(),
+		MaxDurationShouldAcceptFinalizedReport:  time.Duration(offchainConfig.MaxDurationShouldAcceptFinalizedReport).String(),
+		MaxDurationShouldTransmitAcceptedReport: time.Duration(offchainConfig.MaxDurationShouldTransmitAcceptedReport).String(),
+
+		SharedSecretHash:        sharedSecretEncryptions.SharedSecretHash.Hex(),
+		SharedSecretEncryptions: len(sharedSecretEncryptions.Encryptions),
+	}
+
+	for _, pubKey := range offchainConfig.OffchainPublicKeys {
+		info.OffchainConfig.OffchainPublicKeys = append(info.OffchainConfig.OffchainPublicKeys, hex.EncodeToString(pubKey))
+	}
+
+	if local != nil {
+		info.Membership = checkMembership(feedConfig, offchainConfig, sharedSecretEncryptions, local)
+	}
+
+	return info, nil
+}
+
+func checkMembership(
+	feedConfig *chaintypes.FeedConfig,
+	offchainConfig *OffchainConfig,
+	sharedSecretEncryptions SharedSecretEncryptions,
+	local *LocalOracle,
+) *Membership {
+	m := &Membership{
+		OracleIndex:            -1,
+		SignerIndex:            -1,
+		TransmitterIndex:       -1,
+		OffchainPublicKeyIndex: -1,
+		PeerIDIndex:            -1,
+	}
+
+	numOracles := len(feedConfig.Signers)
+	if len(feedConfig.Transmitters) != numOracles ||
+		len(offchainConfig.OffchainPublicKeys) != numOracles ||
+		len(offchainConfig.PeerIds) != numOracles ||
+		len(sharedSecretEncryptions.Encryptions) != numOracles {
+		m.warnf(
+			"number of oracles is inconsistent: %d signers, %d transmitters, %d offchain public keys, %d peer IDs, %d shared secret encryptions",
+			numOracles, len(feedConfig.Transmitters), len(offchainConfig.OffchainPublicKeys),
+			len(offchainConfig.PeerIds), len(sharedSecretEncryptions.Encryptions),
+		)
+	}
+
+	if len(local.Signer) > 0 {
+		if m.SignerIndex = indexOf(feedConfig.Signers, local.Signer); m.SignerIndex < 0 {
+			m.warnf("local signer %s is missing from signers", local.Signer)
+		}
+	}
+
+	if len(local.Transmitters) > 0 {
+		// prefer the transmitter at the signer's index, if there are many
+		for _, transmitter := range local.Transmitters {
+			idx := indexOf(feedConfig.Transmitters, transmitter)
+			if idx < 0 {
+				continue
+			}
+
+			if m.TransmitterIndex < 0 || idx == m.SignerIndex {
+				m.TransmitterIndex = idx
+			}
+		}
+
+		if m.TransmitterIndex < 0 {
+			m.warnf("none of local transmitters %v is listed among transmitters", local.Transmitters)
+		}
+	}
+
+	if len(local.PeerID) > 0 {
+		if m.PeerIDIndex = indexOf(offchainConfig.PeerIds, local.PeerID); m.PeerIDIndex < 0 {
+			m.warnf("local peer ID %s is missing from peer IDs", local.PeerID)
+		}
+	}
+
+	if local.OffchainKeyring != nil {
+		localPubKey := local.OffchainKeyring.OffchainPublicKey()
+		for i, pubKey := range offchainConfig.OffchainPublicKeys {
+			if bytes.Equal(pubKey, localPubKey[:]) {
+				m.OffchainPublicKeyIndex = i
+				break
+			}
+		}
+
+		if m.OffchainPublicKeyIndex < 0 {
+			m.warnf("local offchain public key %x is missing from offchain public keys", localPubKey[:])
+		} else if _, err := sharedSecretEncryptions.Decrypt(
+			commontypes.OracleID(m.OffchainPublicKeyIndex),
+			local.OffchainKeyring,
+		); err != nil {
+			m.warnf("failed to decrypt shared secret with local OCR key: %v", err)
+		} else {
+			m.SharedSecretDecrypted = true
+		}
+	}
+
+	// all checked identities must belong to the same oracle
+	for _, idx := range []int{m.SignerIndex, m.TransmitterIndex, m.OffchainPublicKeyIndex, m.PeerIDIndex} {
+		if idx < 0 {
+			continue
+		}
+
+		if m.OracleIndex < 0 {
+			m.OracleIndex = idx
+		} else if m.OracleIndex != idx {
+			m.warnf(
+				"local identities are found at different oracle indexes: signer %d, transmitter %d, offchain public key %d, peer ID %d",
+				m.SignerIndex, m.TransmitterIndex, m.OffchainPublicKeyIndex, m.PeerIDIndex,
+			)
+			m.OracleIndex = -1
+			break
+		}
+	}
+
+	return m
+}
+
+func indexOf(list []string, v string) int {
+	for i, item := range list {
+		if item == v {
+			return i
+		}
+	}
+
+	return -1
+}
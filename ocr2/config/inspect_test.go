@@ -0,0 +1,132 @@
+package config
+
+import (
+	"crypto/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+	"github.com/InjectiveLabs/chainlink-injective/keys/ocrkey"
+)
+
+var _ = Describe("checkMembership", func() {
+	var (
+		spec           *FeedConfigSpec
+		keyrings       []ocrkey.OCR2KeyWrapper
+		feedConfig     *chaintypes.FeedConfig
+		offchainConfig *OffchainConfig
+		encryptions    SharedSecretEncryptions
+	)
+
+	BeforeEach(func() {
+		spec, keyrings = newTestFeedConfigSpec(4)
+
+		var err error
+		feedConfig, err = BuildFeedConfig(spec, rand.Reader)
+		Expect(err).To(BeNil())
+
+		offchainConfig, err = DecodeConfig(feedConfig.OffchainConfig)
+		Expect(err).To(BeNil())
+
+		encryptions, err = SharedSecretEncryptionsFromProto(offchainConfig.SharedSecretEncryptions)
+		Expect(err).To(BeNil())
+	})
+
+	localOracle := func(idx int) *LocalOracle {
+		return &LocalOracle{
+			Signer:          spec.Oracles[idx].Signer,
+			Transmitters:    []string{spec.Oracles[idx].Transmitter},
+			PeerID:          spec.Oracles[idx].PeerID,
+			OffchainKeyring: keyrings[idx],
+		}
+	}
+
+	It("finds all identities of a valid member at the same index", func() {
+		m := checkMembership(feedConfig, offchainConfig, encryptions, localOracle(2))
+
+		Expect(m.Verify()).To(BeNil())
+		Expect(m.Warnings).To(BeEmpty())
+		Expect(m.OracleIndex).To(Equal(2))
+		Expect(m.SignerIndex).To(Equal(2))
+		Expect(m.TransmitterIndex).To(Equal(2))
+		Expect(m.OffchainPublicKeyIndex).To(Equal(2))
+		Expect(m.PeerIDIndex).To(Equal(2))
+		Expect(m.SharedSecretDecrypted).To(BeTrue())
+	})
+
+	It("checks only the provided identities", func() {
+		m := checkMembership(feedConfig, offchainConfig, encryptions, &LocalOracle{
+			PeerID: spec.Oracles[1].PeerID,
+		})
+
+		Expect(m.Verify()).To(BeNil())
+		Expect(m.OracleIndex).To(Equal(1))
+		Expect(m.SignerIndex).To(Equal(-1))
+		Expect(m.TransmitterIndex).To(Equal(-1))
+		Expect(m.SharedSecretDecrypted).To(BeFalse())
+
+		m = checkMembership(feedConfig, offchainConfig, encryptions, &LocalOracle{})
+		Expect(m.Verify()).ToNot(BeNil())
+		Expect(m.OracleIndex).To(Equal(-1))
+	})
+
+	It("prefers the local transmitter at the signer's index", func() {
+		local := localOracle(3)
+		local.Transmitters = []string{
+			spec.Oracles[0].Transmitter,
+			spec.Oracles[3].Transmitter,
+			spec.Oracles[1].Transmitter,
+		}
+
+		m := checkMembership(feedConfig, offchainConfig, encryptions, local)
+		Expect(m.Verify()).To(BeNil())
+		Expect(m.TransmitterIndex).To(Equal(3))
+	})
+
+	It("warns about missing identities", func() {
+		foreignSpec, foreignKeyrings := newTestFeedConfigSpec(1)
+
+		m := checkMembership(feedConfig, offchainConfig, encryptions, &LocalOracle{
+			Signer:          foreignSpec.Oracles[0].Signer,
+			Transmitters:    []string{foreignSpec.Oracles[0].Transmitter},
+			PeerID:          "unknown-peer",
+			OffchainKeyring: foreignKeyrings[0],
+		})
+
+		Expect(m.Verify()).ToNot(BeNil())
+		Expect(m.Warnings).To(HaveLen(4))
+		Expect(m.OracleIndex).To(Equal(-1))
+		Expect(m.SharedSecretDecrypted).To(BeFalse())
+	})
+
+	It("warns about identities at different oracle indexes", func() {
+		local := localOracle(0)
+		local.PeerID = spec.Oracles[1].PeerID
+
+		m := checkMembership(feedConfig, offchainConfig, encryptions, local)
+		Expect(m.Verify()).ToNot(BeNil())
+		Expect(m.Warnings).To(HaveLen(1))
+		Expect(m.OracleIndex).To(Equal(-1))
+		Expect(m.PeerIDIndex).To(Equal(1))
+	})
+
+	It("warns about the undecryptable shared secret", func() {
+		// the shared secret of oracle 1 is put in place of oracle 0
+		encryptions.Encryptions[0] = encryptions.Encryptions[1]
+
+		m := checkMembership(feedConfig, offchainConfig, encryptions, localOracle(0))
+		Expect(m.Verify()).ToNot(BeNil())
+		Expect(m.OffchainPublicKeyIndex).To(Equal(0))
+		Expect(m.SharedSecretDecrypted).To(BeFalse())
+	})
+
+	It("warns about inconsistent number of oracles", func() {
+		offchainConfig.PeerIds = offchainConfig.PeerIds[:3]
+
+		m := checkMembership(feedConfig, offchainConfig, encryptions, localOracle(1))
+		Expect(m.Verify()).ToNot(BeNil())
+		Expect(m.Warnings).To(HaveLen(1))
+		Expect(m.OracleIndex).To(Equal(1))
+	})
+})
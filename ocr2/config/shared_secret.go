@@ -70,6 +70,38 @@ func (e SharedSecretEncryptions) Proto() *SharedSecretEncryptionsProto {
 	}
 }
 
+// SharedSecretEncryptionsFromProto is the inverse of SharedSecretEncryptions.Proto
+func SharedSecretEncryptionsFromProto(p *SharedSecretEncryptionsProto) (SharedSecretEncryptions, error) {
+	var e SharedSecretEncryptions
+
+	if p == nil {
+		return e, errors.New("shared secret encryptions are missing")
+	}
+
+	if len(p.DiffieHellmanPoint) != len(e.DiffieHellmanPoint) {
+		return e, errors.Errorf("DiffieHellmanPoint has wrong length: %d", len(p.DiffieHellmanPoint))
+	}
+	copy(e.DiffieHellmanPoint[:], p.DiffieHellmanPoint)
+
+	if len(p.SharedSecretHash) != len(e.SharedSecretHash) {
+		return e, errors.Errorf("SharedSecretHash has wrong length: %d", len(p.SharedSecretHash))
+	}
+	copy(e.SharedSecretHash[:], p.SharedSecretHash)
+
+	e.Encryptions = make([]encryptedSharedSecret, 0, len(p.Encryptions))
+	for i, enc := range p.Encryptions {
+		var encrypted encryptedSharedSecret
+		if len(enc) != len(encrypted) {
+			return e, errors.Errorf("encryption %d has wrong length: %d", i, len(enc))
+		}
+
+		copy(encrypted[:], enc)
+		e.Encryptions = append(e.Encryptions, encrypted)
+	}
+
+	return e, nil
+}
+
 // Decrypt one block with AES-128
 func aesDecryptBlock(key, ciphertext []byte) [16]byte {
 	if len(key) != 16 {
@@ -0,0 +1,136 @@
+package ocr2
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/InjectiveLabs/chainlink-injective/db"
+	"github.com/InjectiveLabs/chainlink-injective/p2p"
+)
+
+var _ = Describe("JobStateDB", func() {
+	var (
+		ctx          context.Context
+		storage      db.Storage
+		stateDB      JobStateDB
+		configDigest ocrtypes.ConfigDigest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		storage = db.NewMemoryDBService()
+
+		jobDB, err := storage.JobDBService("job1")
+		Expect(err).To(BeNil())
+
+		stateDB = NewJobDBWrapper(jobDB)
+		configDigest = ocrtypes.ConfigDigest{0x01, 0x02}
+	})
+
+	It("stores persistent state", func() {
+		state, err := stateDB.ReadState(ctx, configDigest)
+		Expect(err).To(BeNil())
+		Expect(state).To(BeNil())
+
+		Expect(stateDB.WriteState(ctx, configDigest, ocrtypes.PersistentState{
+			Epoch:                3,
+			HighestSentEpoch:     2,
+			HighestReceivedEpoch: []uint32{1, 2, 3, 4},
+		})).To(BeNil())
+
+		state, err = stateDB.ReadState(ctx, configDigest)
+		Expect(err).To(BeNil())
+		Expect(state).To(Equal(&ocrtypes.PersistentState{
+			Epoch:                3,
+			HighestSentEpoch:     2,
+			HighestReceivedEpoch: []uint32{1, 2, 3, 4},
+		}))
+	})
+
+	It("stores contract config", func() {
+		config, err := stateDB.ReadConfig(ctx)
+		Expect(err).To(BeNil())
+		Expect(config).To(BeNil())
+
+		expected := ocrtypes.ContractConfig{
+			ConfigDigest:          configDigest,
+			ConfigCount:           1,
+			Signers:               []ocrtypes.OnchainPublicKey{{0x01}},
+			Transmitters:          []ocrtypes.Account{"inj1transmitter"},
+			F:                     1,
+			OnchainConfig:         []byte{0x02},
+			OffchainConfigVersion: 2,
+			OffchainConfig:        []byte{0x03},
+		}
+		Expect(stateDB.WriteConfig(ctx, expected)).To(BeNil())
+
+		config, err = stateDB.ReadConfig(ctx)
+		Expect(err).To(BeNil())
+		Expect(*config).To(Equal(expected))
+	})
+
+	It("stores pending transmissions", func() {
+		txTime := time.Now().UTC()
+		reportTimestamp := ocrtypes.ReportTimestamp{
+			ConfigDigest: configDigest,
+			Epoch:        3,
+			Round:        1,
+		}
+
+		Expect(stateDB.StorePendingTransmission(ctx, reportTimestamp, ocrtypes.PendingTransmission{
+			Time:      txTime,
+			ExtraHash: [32]byte{0x01},
+			Report:    ocrtypes.Report{0x02},
+			AttributedSignatures: []ocrtypes.AttributedOnchainSignature{{
+				Signature: []byte{0x03},
+				Signer:    commontypes.OracleID(1),
+			}},
+		})).To(BeNil())
+
+		pending, err := stateDB.PendingTransmissionsWithConfigDigest(ctx, configDigest)
+		Expect(err).To(BeNil())
+		Expect(pending).To(HaveLen(1))
+
+		tx, ok := pending[ocrtypes.ReportTimestamp{Epoch: 3, Round: 1}]
+		Expect(ok).To(BeTrue())
+		Expect(tx.Time).To(BeTemporally("==", txTime))
+		Expect(tx.ExtraHash).To(Equal([32]byte{0x01}))
+		Expect(tx.Report).To(Equal(ocrtypes.Report{0x02}))
+		Expect(tx.AttributedSignatures).To(Equal([]ocrtypes.AttributedOnchainSignature{{
+			Signature: []byte{0x03},
+			Signer:    commontypes.OracleID(1),
+		}}))
+
+		Expect(stateDB.DeletePendingTransmissionsOlderThan(ctx, txTime.Add(-time.Minute))).To(BeNil())
+
+		pending, err = stateDB.PendingTransmissionsWithConfigDigest(ctx, configDigest)
+		Expect(err).To(BeNil())
+		Expect(pending).To(HaveLen(1))
+
+		Expect(stateDB.DeletePendingTransmission(ctx, reportTimestamp)).To(BeNil())
+
+		pending, err = stateDB.PendingTransmissionsWithConfigDigest(ctx, configDigest)
+		Expect(err).To(BeNil())
+		Expect(pending).To(BeEmpty())
+	})
+
+	It("stores peer announcements", func() {
+		jobDB, err := storage.JobDBService("job1")
+		Expect(err).To(BeNil())
+
+		peerDB := p2p.NewAnnounceDBWrapper(jobDB)
+		Expect(peerDB.StoreAnnouncement(ctx, "peer1", []byte{0x01})).To(BeNil())
+		Expect(peerDB.StoreAnnouncement(ctx, "peer1", []byte{0x02})).To(BeNil())
+
+		announcements, err := peerDB.ReadAnnouncements(ctx, []string{"peer1", "peer2"})
+		Expect(err).To(BeNil())
+		Expect(announcements).To(Equal(map[string][]byte{
+			"peer1": {0x02},
+		}))
+	})
+})
@@ -0,0 +1,201 @@
+package ocr2
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/chainlink-injective/chainlink"
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+// runnableDataSource is a data source that receives its results via
+// job runs (POST /runs), rather than fetching them on Observe.
+type runnableDataSource interface {
+	median.DataSource
+
+	Run(result *big.Int) error
+}
+
+var ErrRunNotSupported = errors.New("job data source doesn't accept runs")
+
+// newJobDataSource inits the data source of a job. If multiple data sources are
+// specified, their results are medianized into a single observation.
+func newJobDataSource(
+	jobID string,
+	jobSpec *model.JobSpec,
+	client chainlink.WebhookClient,
+) (median.DataSource, error) {
+	if len(jobSpec.DataSources) == 0 {
+		return newDataSource(jobID, jobSpec.DataSource, client)
+	} else if jobSpec.DataSource != nil {
+		err := errors.New("dataSource and dataSources cannot be used together")
+		return nil, err
+	}
+
+	ds := &dsMedian{
+		sources:      make([]*namedDataSource, 0, len(jobSpec.DataSources)),
+		minResponses: jobSpec.DataSourcesMinResponses,
+
+		logger: log.WithFields(log.Fields{
+			"svc":   "ocr2_ds_median",
+			"jobID": jobID,
+		}),
+	}
+
+	if ds.minResponses <= 0 {
+		ds.minResponses = 1
+	} else if ds.minResponses > len(jobSpec.DataSources) {
+		err := errors.Errorf("dataSourcesMinResponses %d exceeds the number of data sources", ds.minResponses)
+		return nil, err
+	}
+
+	if len(jobSpec.DataSourcesMaxDeviation) > 0 {
+		maxDeviation, err := decimal.NewFromString(jobSpec.DataSourcesMaxDeviation)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to parse dataSourcesMaxDeviation: %s", jobSpec.DataSourcesMaxDeviation)
+			return nil, err
+		}
+
+		ds.maxDeviation = maxDeviation
+	}
+
+	names := make(map[string]struct{}, len(jobSpec.DataSources))
+
+	// runs carry a single value for the job, so it can't be routed to
+	// several webhook sources, they'd all observe the same value
+	var hasWebhook bool
+
+	for idx, spec := range jobSpec.DataSources {
+		if spec == nil {
+			err := errors.Errorf("data source #%d spec is empty", idx)
+			return nil, err
+		}
+
+		if spec.Type == model.DataSourceWebhook || len(spec.Type) == 0 {
+			if hasWebhook {
+				err := errors.New("only one webhook data source is allowed per job")
+				return nil, err
+			}
+
+			hasWebhook = true
+		}
+
+		source, err := newDataSource(jobID, spec, client)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to init data source #%d", idx)
+			return nil, err
+		}
+
+		name := spec.Name
+		if len(name) == 0 {
+			name = fmt.Sprintf("%s_%d", spec.Type, idx)
+		}
+
+		if _, ok := names[name]; ok {
+			err := errors.Errorf("duplicate data source name: %s", name)
+			return nil, err
+		}
+		names[name] = struct{}{}
+
+		var timeout time.Duration
+		if len(spec.Timeout) > 0 {
+			if timeout, err = time.ParseDuration(spec.Timeout); err != nil {
+				err = errors.Wrapf(err, "failed to parse timeout of data source %s", name)
+				return nil, err
+			}
+		}
+
+		ds.sources = append(ds.sources, &namedDataSource{
+			name:    name,
+			timeout: timeout,
+			ds:      source,
+			svcTags: metrics.Tags{
+				"svc":    "ocr2_ds",
+				"job":    jobID,
+				"source": name,
+			},
+		})
+	}
+
+	return ds, nil
+}
+
+// juelsPerFeeCoinMaxAge limits for how long the last known JuelsPerFeeCoin
+// value is reused when its data source fails.
+const juelsPerFeeCoinMaxAge = time.Hour
+
+// newJuelsPerFeeCoinDataSource inits the JuelsPerFeeCoin data source of a job. The
+// source is wrapped with a fallback, so a failing source never fails the observation.
+func newJuelsPerFeeCoinDataSource(
+	jobID string,
+	jobSpec *model.JobSpec,
+) (median.DataSource, error) {
+	fallback := new(big.Int)
+	if len(jobSpec.JuelsPerFeeCoinFallback) > 0 {
+		if _, ok := fallback.SetString(jobSpec.JuelsPerFeeCoinFallback, 10); !ok {
+			err := errors.Errorf("failed to parse juelsPerFeeCoinFallback %s as big.Int", jobSpec.JuelsPerFeeCoinFallback)
+			return nil, err
+		}
+	}
+
+	spec := jobSpec.JuelsPerFeeCoinSource
+	if spec == nil {
+		return &dsStatic{
+			value: fallback,
+		}, nil
+	}
+
+	switch spec.Type {
+	case model.DataSourceWebhook, "":
+		err := errors.New("JuelsPerFeeCoin data source cannot be a webhook")
+		return nil, err
+	}
+
+	source, err := newDataSource(jobID, spec, nil)
+	if err != nil {
+		err = errors.Wrap(err, "failed to init JuelsPerFeeCoin data source")
+		return nil, err
+	}
+
+	return &dsFallback{
+		source:   source,
+		fallback: fallback,
+		maxAge:   juelsPerFeeCoinMaxAge,
+
+		logger: log.WithFields(log.Fields{
+			"svc":   "ocr2_ds_juels",
+			"jobID": jobID,
+		}),
+	}, nil
+}
+
+// newDataSource inits the data source of a job according to the spec. Jobs
+// without a data source spec fall back to the Chainlink node webhook.
+func newDataSource(
+	jobID string,
+	spec *model.DataSourceSpec,
+	client chainlink.WebhookClient,
+) (median.DataSource, error) {
+	if spec == nil {
+		return newWebhookDataSource(jobID, nil, client)
+	}
+
+	switch spec.Type {
+	case model.DataSourceWebhook, "":
+		return newWebhookDataSource(jobID, spec, client)
+	case model.DataSourceHTTP:
+		return newHTTPDataSource(jobID, spec)
+	case model.DataSourceStatic:
+		return newStaticDataSource(spec)
+	default:
+		err := errors.Errorf("unsupported data source type: %s", spec.Type)
+		return nil, err
+	}
+}
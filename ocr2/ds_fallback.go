@@ -0,0 +1,48 @@
+package ocr2
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	log "github.com/xlab/suplog"
+)
+
+// dsFallback never fails the observation: if the source fails, it reuses the last
+// value observed within maxAge, otherwise returns the fallback value.
+type dsFallback struct {
+	source   median.DataSource
+	fallback *big.Int
+	maxAge   time.Duration
+
+	lastMux     sync.Mutex
+	lastValue   *big.Int
+	lastUpdated time.Time
+
+	logger log.Logger
+}
+
+func (d *dsFallback) Observe(ctx context.Context) (*big.Int, error) {
+	value, err := d.source.Observe(ctx)
+	if err == nil && value != nil {
+		d.lastMux.Lock()
+		d.lastValue = value
+		d.lastUpdated = time.Now()
+		d.lastMux.Unlock()
+
+		return new(big.Int).Set(value), nil
+	}
+
+	d.lastMux.Lock()
+	defer d.lastMux.Unlock()
+
+	if d.lastValue != nil && time.Since(d.lastUpdated) < d.maxAge {
+		d.logger.WithError(err).Warningln("data source failed, reusing the last value observed", time.Since(d.lastUpdated), "ago")
+		return new(big.Int).Set(d.lastValue), nil
+	}
+
+	d.logger.WithError(err).WithField("fallback", d.fallback.String()).Warningln("data source failed, using the fallback value")
+	return new(big.Int).Set(d.fallback), nil
+}
@@ -0,0 +1,257 @@
+package ocr2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+const maxHTTPResponseSize = 1024 * 1024
+
+// dsHTTP fetches a JSON document from an HTTP endpoint and extracts the
+// observed value using a jq path. The value is multiplied by the multiplier
+// and rounded to an integer, e.g. multiplier of 1e18 for 18 decimals.
+//
+// jq handles non-integer numbers as float64, so if the jq path is a plain path expression,
+// the value is looked up in the document by the path instead, keeping its precision.
+type dsHTTP struct {
+	url        string
+	headers    map[string]string
+	query      *gojq.Code
+	pathQuery  *gojq.Code
+	multiplier decimal.Decimal
+
+	c      *http.Client
+	logger log.Logger
+}
+
+func newHTTPDataSource(jobID string, spec *model.DataSourceSpec) (*dsHTTP, error) {
+	if len(spec.URL) == 0 {
+		return nil, errors.New("HTTP data source requires url")
+	} else if len(spec.Path) == 0 {
+		return nil, errors.New("HTTP data source requires jq path")
+	}
+
+	query, err := gojq.Parse(spec.Path)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to parse jq path: %s", spec.Path)
+		return nil, err
+	}
+
+	code, err := gojq.Compile(query)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to compile jq path: %s", spec.Path)
+		return nil, err
+	}
+
+	// not every jq expression is a path, such ones are evaluated by jq only
+	var pathCode *gojq.Code
+	if pathQuery, err := gojq.Parse("path(" + spec.Path + ")"); err == nil {
+		pathCode, _ = gojq.Compile(pathQuery)
+	}
+
+	multiplier := decimal.NewFromInt(1)
+	if len(spec.Multiplier) > 0 {
+		if multiplier, err = decimal.NewFromString(spec.Multiplier); err != nil {
+			err = errors.Wrapf(err, "failed to parse multiplier: %s", spec.Multiplier)
+			return nil, err
+		}
+	}
+
+	return &dsHTTP{
+		url:        spec.URL,
+		headers:    spec.Headers,
+		query:      code,
+		pathQuery:  pathCode,
+		multiplier: multiplier,
+
+		c: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+		logger: log.WithFields(log.Fields{
+			"svc":   "ocr2_ds_http",
+			"jobID": jobID,
+		}),
+	}, nil
+}
+
+func (d *dsHTTP) Observe(ctx context.Context) (*big.Int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.url, nil)
+	if err != nil {
+		err = errors.Wrap(err, "failed to create HTTP request")
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	for k, v := range d.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := d.c.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ErrObserveTimeout
+		}
+
+		err = errors.Wrap(err, "HTTP request failed")
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseSize))
+	if err != nil {
+		err = errors.Wrap(err, "failed to read HTTP response body")
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err = errors.Errorf("HTTP request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, err
+	}
+
+	v, err := d.extractValue(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := decimalFromJSONValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.Mul(d.multiplier).Round(0).BigInt(), nil
+}
+
+// extractValue runs the jq path against the JSON document. Numbers looked up by path
+// are returned as json.Number.
+func (d *dsHTTP) extractValue(ctx context.Context, body []byte) (interface{}, error) {
+	if d.pathQuery != nil {
+		// jq normalizes numbers of the input in place, so it gets its own copy
+		var doc, jqDoc interface{}
+		if err := unmarshalJSONUseNumber(body, &doc); err != nil {
+			return nil, err
+		} else if err := unmarshalJSONUseNumber(body, &jqDoc); err != nil {
+			return nil, err
+		}
+
+		iter := d.pathQuery.RunWithContext(ctx, jqDoc)
+		if p, ok := iter.Next(); ok {
+			if path, ok := p.([]interface{}); ok {
+				if v, ok := lookupJSONPath(doc, path); ok {
+					return v, nil
+				}
+			}
+		}
+	}
+
+	var doc interface{}
+	if err := unmarshalJSONUseNumber(body, &doc); err != nil {
+		return nil, err
+	}
+
+	iter := d.query.RunWithContext(ctx, doc)
+	v, ok := iter.Next()
+	if !ok {
+		return nil, errors.New("jq path yields no value")
+	} else if err, ok := v.(error); ok {
+		err = errors.Wrap(err, "failed to run jq path")
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func unmarshalJSONUseNumber(body []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	if err := dec.Decode(v); err != nil {
+		err = errors.Wrap(err, "failed to unmarshal JSON response")
+		return err
+	}
+
+	return nil
+}
+
+// lookupJSONPath returns the value of the document at path yielded by jq path().
+func lookupJSONPath(doc interface{}, path []interface{}) (interface{}, bool) {
+	v := doc
+
+	for _, key := range path {
+		switch key := key.(type) {
+		case string:
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+
+			if v, ok = obj[key]; !ok {
+				return nil, false
+			}
+		case int, float64:
+			arr, ok := v.([]interface{})
+			if !ok {
+				return nil, false
+			}
+
+			idx, ok := key.(int)
+			if !ok {
+				idx = int(key.(float64))
+			}
+			if idx < 0 {
+				idx += len(arr)
+			}
+			if idx < 0 || idx >= len(arr) {
+				return nil, false
+			}
+
+			v = arr[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+func decimalFromJSONValue(v interface{}) (decimal.Decimal, error) {
+	switch v := v.(type) {
+	case json.Number:
+		value, err := decimal.NewFromString(v.String())
+		if err != nil {
+			err = errors.Wrapf(err, "failed to parse jq result %s as decimal", v)
+			return decimal.Decimal{}, err
+		}
+
+		return value, nil
+	case int:
+		return decimal.NewFromInt(int64(v)), nil
+	case float64:
+		return decimal.NewFromFloat(v), nil
+	case *big.Int:
+		return decimal.NewFromBigInt(v, 0), nil
+	case string:
+		value, err := decimal.NewFromString(v)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to parse jq result %s as decimal", v)
+			return decimal.Decimal{}, err
+		}
+
+		return value, nil
+	default:
+		err := errors.Errorf("unsupported jq result type %T", v)
+		return decimal.Decimal{}, err
+	}
+}
@@ -0,0 +1,156 @@
+package ocr2
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+// dsMedian queries multiple data sources concurrently and returns the median
+// of the results that arrived before the deadline, with outliers dropped.
+type dsMedian struct {
+	sources      []*namedDataSource
+	minResponses int
+	maxDeviation decimal.Decimal
+
+	logger log.Logger
+}
+
+type namedDataSource struct {
+	name    string
+	timeout time.Duration
+	ds      median.DataSource
+	svcTags metrics.Tags
+}
+
+type dataSourceResult struct {
+	name  string
+	value *big.Int
+	err   error
+}
+
+func (d *dsMedian) Observe(ctx context.Context) (*big.Int, error) {
+	resultsC := make(chan dataSourceResult, len(d.sources))
+
+	for _, source := range d.sources {
+		go func(source *namedDataSource) {
+			resultsC <- source.observe(ctx)
+		}(source)
+	}
+
+	values := make([]*big.Int, 0, len(d.sources))
+
+	// once the context expires, proceed with the values already received
+collectLoop:
+	for pending := len(d.sources); pending > 0; pending-- {
+		select {
+		case <-ctx.Done():
+			break collectLoop
+		case result := <-resultsC:
+			if result.err != nil {
+				d.logger.WithError(result.err).WithField("source", result.name).Warningln("data source failed")
+				continue
+			}
+
+			values = append(values, result.value)
+		}
+	}
+
+	if len(values) < d.minResponses {
+		if ctx.Err() != nil {
+			return nil, ErrObserveTimeout
+		}
+
+		err := errors.Errorf("got %d data source responses, need at least %d", len(values), d.minResponses)
+		return nil, err
+	}
+
+	values = dropOutliers(values, d.maxDeviation)
+	if len(values) < d.minResponses {
+		err := errors.Errorf("got %d data source responses after dropping outliers, need at least %d", len(values), d.minResponses)
+		return nil, err
+	}
+
+	return medianOf(values), nil
+}
+
+// Run delivers the result to the webhook data source, there is at most one per job.
+func (d *dsMedian) Run(result *big.Int) error {
+	for _, source := range d.sources {
+		if runnable, ok := source.ds.(runnableDataSource); ok {
+			return runnable.Run(result)
+		}
+	}
+
+	return ErrRunNotSupported
+}
+
+func (s *namedDataSource) observe(ctx context.Context) (result dataSourceResult) {
+	metrics.ReportClosureFuncCall("ds_observe", s.svcTags)
+	doneFn := metrics.ReportClosureFuncTiming("ds_observe", s.svcTags)
+	defer doneFn()
+
+	if s.timeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, s.timeout)
+		defer cancelFn()
+	}
+
+	result.name = s.name
+	result.value, result.err = s.ds.Observe(ctx)
+	if result.err == nil && result.value == nil {
+		result.err = errors.New("data source returned no value")
+	}
+
+	if result.err != nil {
+		metrics.ReportClosureFuncError("ds_observe", s.svcTags)
+		return result
+	}
+
+	metrics.ReportClosureFuncSuccess("ds_observe", s.svcTags)
+	return result
+}
+
+// dropOutliers removes values deviating from the median by more than maxDeviation
+// fraction of it. Values are kept as-is if maxDeviation is zero or the median is zero.
+func dropOutliers(values []*big.Int, maxDeviation decimal.Decimal) []*big.Int {
+	if len(values) < 3 || !maxDeviation.IsPositive() {
+		return values
+	}
+
+	m := decimal.NewFromBigInt(medianOf(values), 0)
+	if m.IsZero() {
+		return values
+	}
+
+	filtered := make([]*big.Int, 0, len(values))
+	for _, v := range values {
+		deviation := decimal.NewFromBigInt(v, 0).Sub(m).Div(m).Abs()
+		if deviation.LessThanOrEqual(maxDeviation) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
+
+// medianOf returns the median value, picking the upper one for even
+// number of values, same as the OCR2 median plugin does.
+func medianOf(values []*big.Int) *big.Int {
+	sorted := make([]*big.Int, len(values))
+	copy(sorted, values)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	return new(big.Int).Set(sorted[len(sorted)/2])
+}
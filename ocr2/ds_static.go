@@ -0,0 +1,30 @@
+package ocr2
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+)
+
+type dsStatic struct {
+	value *big.Int
+}
+
+func newStaticDataSource(spec *model.DataSourceSpec) (*dsStatic, error) {
+	value, ok := new(big.Int).SetString(spec.Value, 10)
+	if !ok {
+		err := errors.Errorf("failed to parse static data source value %s as big.Int", spec.Value)
+		return nil, err
+	}
+
+	return &dsStatic{
+		value: value,
+	}, nil
+}
+
+func (d *dsStatic) Observe(ctx context.Context) (*big.Int, error) {
+	return new(big.Int).Set(d.value), nil
+}
@@ -0,0 +1,409 @@
+package ocr2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+var _ = Describe("Data sources", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("static", func() {
+		It("observes the configured value", func() {
+			ds, err := newStaticDataSource(&model.DataSourceSpec{
+				Value: "123456789012345678901234567890",
+			})
+			Expect(err).To(BeNil())
+
+			value, err := ds.Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.String()).To(Equal("123456789012345678901234567890"))
+
+			// the returned value is a copy
+			value.SetInt64(1)
+			value, err = ds.Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.String()).To(Equal("123456789012345678901234567890"))
+		})
+
+		It("rejects non-integer values", func() {
+			_, err := newStaticDataSource(&model.DataSourceSpec{
+				Value: "1.5",
+			})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe("HTTP", func() {
+		var (
+			server   *httptest.Server
+			response string
+			status   int
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("X-Api-Key")).To(Equal("secret"))
+
+				w.WriteHeader(status)
+				_, _ = fmt.Fprint(w, response)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newDS := func(path, multiplier string) *dsHTTP {
+			ds, err := newHTTPDataSource("job1", &model.DataSourceSpec{
+				Type:       model.DataSourceHTTP,
+				URL:        server.URL,
+				Headers:    map[string]string{"X-Api-Key": "secret"},
+				Path:       path,
+				Multiplier: multiplier,
+			})
+			Expect(err).To(BeNil())
+
+			return ds
+		}
+
+		It("keeps precision of large decimal numbers", func() {
+			response = `{"data": {"prices": [1, 98765432109876.123456789012345678]}}`
+
+			value, err := newDS(".data.prices[1]", "1000000000000000000").Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.String()).To(Equal("98765432109876123456789012345678"))
+
+			value, err = newDS(".data.prices[-1]", "").Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.String()).To(Equal("98765432109876"))
+		})
+
+		It("keeps precision of large integers", func() {
+			response = `{"price": 123456789012345678901234567890}`
+
+			value, err := newDS(".price", "").Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.String()).To(Equal("123456789012345678901234567890"))
+		})
+
+		It("parses numbers in strings", func() {
+			response = `{"price": "1.000000000000000001"}`
+
+			value, err := newDS(".price", "1000000000000000000").Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.String()).To(Equal("1000000000000000001"))
+		})
+
+		It("evaluates jq expressions that aren't paths", func() {
+			response = `{"bid": 10, "ask": 20}`
+
+			value, err := newDS("(.bid + .ask) / 2", "").Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.String()).To(Equal("15"))
+		})
+
+		It("fails on missing values and HTTP errors", func() {
+			response = `{"price": 1}`
+
+			_, err := newDS(".missing", "").Observe(ctx)
+			Expect(err).ToNot(BeNil())
+
+			status = http.StatusInternalServerError
+			_, err = newDS(".price", "").Observe(ctx)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("rejects invalid specs", func() {
+			_, err := newHTTPDataSource("job1", &model.DataSourceSpec{Path: ".price"})
+			Expect(err).ToNot(BeNil())
+
+			_, err = newHTTPDataSource("job1", &model.DataSourceSpec{URL: server.URL, Path: ".["})
+			Expect(err).ToNot(BeNil())
+
+			_, err = newHTTPDataSource("job1", &model.DataSourceSpec{URL: server.URL, Path: ".price", Multiplier: "x"})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe("median", func() {
+		newMedian := func(minResponses int, maxDeviation string, sources ...median.DataSource) *dsMedian {
+			ds := &dsMedian{
+				minResponses: minResponses,
+				maxDeviation: decimal.RequireFromString(maxDeviation),
+				logger:       log.WithField("svc", "ocr2_ds_median"),
+			}
+
+			for idx, source := range sources {
+				ds.sources = append(ds.sources, &namedDataSource{
+					name:    fmt.Sprintf("source_%d", idx),
+					ds:      source,
+					svcTags: metrics.Tags{"svc": "ocr2_ds"},
+				})
+			}
+
+			return ds
+		}
+
+		It("returns the median of responses, dropping failed sources", func() {
+			ds := newMedian(2, "0",
+				staticValue(10),
+				staticValue(30),
+				&failingDataSource{},
+				staticValue(20),
+			)
+
+			value, err := ds.Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.Int64()).To(Equal(int64(20)))
+		})
+
+		It("drops outliers", func() {
+			ds := newMedian(3, "0.1", staticValue(100), staticValue(101), staticValue(99), staticValue(1000))
+
+			value, err := ds.Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.Int64()).To(Equal(int64(100)))
+		})
+
+		It("fails without enough responses", func() {
+			ds := newMedian(2, "0", staticValue(10), &failingDataSource{})
+
+			_, err := ds.Observe(ctx)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("proceeds with responses received before the timeout", func() {
+			ds := newMedian(1, "0", staticValue(10), &blockingDataSource{})
+
+			timeoutCtx, cancelFn := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancelFn()
+
+			value, err := ds.Observe(timeoutCtx)
+			Expect(err).To(BeNil())
+			Expect(value.Int64()).To(Equal(int64(10)))
+		})
+
+		It("rejects invalid specs", func() {
+			_, err := newJobDataSource("job1", &model.JobSpec{
+				DataSources: []*model.DataSourceSpec{
+					{Type: model.DataSourceStatic, Name: "a", Value: "1"},
+					{Type: model.DataSourceStatic, Name: "a", Value: "2"},
+				},
+			}, nil)
+			Expect(err).ToNot(BeNil())
+
+			_, err = newJobDataSource("job1", &model.JobSpec{
+				DataSources: []*model.DataSourceSpec{
+					{Type: model.DataSourceStatic, Value: "1"},
+				},
+				DataSourcesMinResponses: 2,
+			}, nil)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("allows only one webhook source", func() {
+			client := &fakeWebhookClient{}
+
+			ds, err := newJobDataSource("job1", &model.JobSpec{
+				DataSources: []*model.DataSourceSpec{
+					{Type: model.DataSourceWebhook},
+					{Type: model.DataSourceStatic, Value: "1"},
+				},
+			}, client)
+			Expect(err).To(BeNil())
+			Expect(ds.(runnableDataSource).Run(big.NewInt(1))).To(BeNil())
+
+			_, err = newJobDataSource("job1", &model.JobSpec{
+				DataSources: []*model.DataSourceSpec{
+					{Type: model.DataSourceWebhook, Name: "a"},
+					{Name: "b"},
+				},
+			}, client)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe("webhook", func() {
+		var (
+			client *fakeWebhookClient
+			ds     *dsWebhook
+		)
+
+		BeforeEach(func() {
+			client = &fakeWebhookClient{}
+
+			var err error
+			ds, err = newWebhookDataSource("job1", nil, client)
+			Expect(err).To(BeNil())
+		})
+
+		It("triggers the job and waits for the run result", func() {
+			client.onTrigger = func() error {
+				go func() {
+					defer GinkgoRecover()
+					Expect(ds.Run(big.NewInt(42))).To(BeNil())
+				}()
+
+				return nil
+			}
+
+			timeoutCtx, cancelFn := context.WithTimeout(ctx, time.Second)
+			defer cancelFn()
+
+			value, err := ds.Observe(timeoutCtx)
+			Expect(err).To(BeNil())
+			Expect(value.Int64()).To(Equal(int64(42)))
+			Expect(client.triggers()).To(Equal(1))
+		})
+
+		It("returns the trigger error without waiting for the timeout", func() {
+			client.onTrigger = func() error {
+				return errors.New("node is down")
+			}
+
+			timeoutCtx, cancelFn := context.WithTimeout(ctx, time.Minute)
+			defer cancelFn()
+
+			ts := time.Now()
+			_, err := ds.Observe(timeoutCtx)
+			Expect(err).ToNot(BeNil())
+			Expect(err).ToNot(Equal(ErrObserveTimeout))
+			Expect(time.Since(ts)).To(BeNumerically("<", time.Second))
+		})
+
+		It("times out without a run result", func() {
+			timeoutCtx, cancelFn := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancelFn()
+
+			_, err := ds.Observe(timeoutCtx)
+			Expect(err).To(Equal(ErrObserveTimeout))
+		})
+
+		It("doesn't shift matches of later results after a lost result", func() {
+			lostCtx, cancelLost := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancelLost()
+
+			_, err := ds.Observe(lostCtx)
+			Expect(err).To(Equal(ErrObserveTimeout))
+
+			client.onTrigger = func() error {
+				go func() {
+					defer GinkgoRecover()
+					Expect(ds.Run(big.NewInt(42))).To(BeNil())
+				}()
+
+				return nil
+			}
+
+			timeoutCtx, cancelFn := context.WithTimeout(ctx, time.Second)
+			defer cancelFn()
+
+			value, err := ds.Observe(timeoutCtx)
+			Expect(err).To(BeNil())
+			Expect(value.Int64()).To(Equal(int64(42)))
+		})
+
+		It("drops triggers past their deadline before matching a result", func() {
+			expiredCtx, cancelFn := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+			defer cancelFn()
+
+			ds.resultsMux.Lock()
+			ds.pending = append(ds.pending, &webhookTrigger{seq: 1, expiresAt: time.Now().Add(-time.Second)})
+			ds.nextSeq = 2
+			trigger := ds.addTrigger(expiredCtx, time.Now().Add(-2*time.Second))
+			Expect(ds.pending).To(HaveLen(2))
+			ds.resultsMux.Unlock()
+
+			Expect(ds.Run(big.NewInt(7))).To(BeNil())
+
+			ds.resultsMux.Lock()
+			defer ds.resultsMux.Unlock()
+
+			Expect(ds.pending).To(BeEmpty())
+			Expect(ds.latest.seq).To(Equal(trigger.seq))
+		})
+
+		It("answers from a fresh cached result within maxAge", func() {
+			var err error
+			ds, err = newWebhookDataSource("job1", &model.DataSourceSpec{MaxAge: "1m"}, client)
+			Expect(err).To(BeNil())
+
+			Expect(ds.Run(big.NewInt(7))).To(BeNil())
+
+			value, err := ds.Observe(ctx)
+			Expect(err).To(BeNil())
+			Expect(value.Int64()).To(Equal(int64(7)))
+			Expect(client.triggers()).To(BeZero())
+		})
+	})
+})
+
+func staticValue(v int64) median.DataSource {
+	return &dsStatic{
+		value: big.NewInt(v),
+	}
+}
+
+type failingDataSource struct{}
+
+func (d *failingDataSource) Observe(ctx context.Context) (*big.Int, error) {
+	return nil, errors.New("source failed")
+}
+
+type blockingDataSource struct{}
+
+func (d *blockingDataSource) Observe(ctx context.Context) (*big.Int, error) {
+	<-ctx.Done()
+	return nil, ErrObserveTimeout
+}
+
+type fakeWebhookClient struct {
+	mux       sync.Mutex
+	calls     int
+	onTrigger func() error
+}
+
+func (c *fakeWebhookClient) TriggerJob(jobID string) error {
+	c.mux.Lock()
+	c.calls++
+	onTrigger := c.onTrigger
+	c.mux.Unlock()
+
+	if onTrigger == nil {
+		return nil
+	}
+
+	return onTrigger()
+}
+
+func (c *fakeWebhookClient) CheckHealth(ctx context.Context) error {
+	return nil
+}
+
+func (c *fakeWebhookClient) triggers() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.calls
+}
@@ -0,0 +1,252 @@
+package ocr2
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/chainlink-injective/chainlink"
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
+)
+
+const (
+	// defaultTriggerTTL is for how long a result is expected for
+	// a trigger, if the Observe context has no deadline.
+	defaultTriggerTTL = time.Minute
+
+	// maxPendingTriggers limits the number of triggers awaiting results.
+	maxPendingTriggers = 32
+)
+
+// dsWebhook triggers the job on the Chainlink node and waits for the
+// result to be delivered back via POST /runs.
+//
+// Run results carry no reference to the trigger, so they are matched to
+// pending triggers in FIFO order. A trigger is dropped once its Observe deadline
+// passes, so a lost result doesn't shift the matches of all later results, it may
+// only answer the next trigger if it arrives after all. A result matched to an older
+// trigger than the latest one is late: it doesn't answer the current Observe, but it's still cached.
+// If maxAge is set, Observe answers from a cached result younger than maxAge
+// without triggering the Chainlink node.
+type dsWebhook struct {
+	jobID  string
+	client chainlink.WebhookClient
+	maxAge time.Duration
+
+	resultsMux *sync.Mutex
+	nextSeq    uint64
+	pending    []*webhookTrigger
+	latest     *webhookResult
+	updatedC   chan struct{}
+
+	logger  log.Logger
+	svcTags metrics.Tags
+}
+
+type webhookTrigger struct {
+	seq       uint64
+	expiresAt time.Time
+	err       error
+}
+
+type webhookResult struct {
+	seq        uint64
+	value      *big.Int
+	receivedAt time.Time
+}
+
+func newWebhookDataSource(
+	jobID string,
+	spec *model.DataSourceSpec,
+	client chainlink.WebhookClient,
+) (*dsWebhook, error) {
+	d := &dsWebhook{
+		jobID:  jobID,
+		client: client,
+
+		resultsMux: new(sync.Mutex),
+		nextSeq:    1,
+		updatedC:   make(chan struct{}),
+
+		logger: log.WithFields(log.Fields{
+			"svc":   "ocr2_ds_webhook",
+			"jobID": jobID,
+		}),
+		svcTags: metrics.Tags{
+			"svc": "ocr2_ds_webhook",
+			"job": jobID,
+		},
+	}
+
+	if spec != nil && len(spec.MaxAge) > 0 {
+		maxAge, err := time.ParseDuration(spec.MaxAge)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to parse webhook data source maxAge: %s", spec.MaxAge)
+			return nil, err
+		}
+
+		d.maxAge = maxAge
+	}
+
+	return d, nil
+}
+
+func (d *dsWebhook) Observe(ctx context.Context) (*big.Int, error) {
+	ts := time.Now()
+
+	d.resultsMux.Lock()
+	if latest := d.latest; latest != nil && d.maxAge > 0 {
+		age := ts.Sub(latest.receivedAt)
+		if age < d.maxAge {
+			d.resultsMux.Unlock()
+
+			metrics.ReportClosureFuncStatus("webhook_result_cached", d.svcTags)
+			return new(big.Int).Set(latest.value), nil
+		}
+
+		metrics.ReportClosureFuncStatus("webhook_result_stale", d.svcTags)
+		d.logger.WithField("age", age.String()).Debugln("cached run result is stale, triggering a new run")
+	}
+
+	trigger := d.addTrigger(ctx, ts)
+	d.resultsMux.Unlock()
+
+	go func() {
+		if err := d.client.TriggerJob(d.jobID); err != nil {
+			d.logger.WithError(err).Errorln("failed to trigger Job on the Chainlink node")
+
+			// no result is expected for the failed trigger
+			d.resultsMux.Lock()
+			d.removeTrigger(trigger.seq)
+			trigger.err = errors.Wrap(err, "failed to trigger Job on the Chainlink node")
+			d.notifyUpdated()
+			d.resultsMux.Unlock()
+		}
+	}()
+
+	for {
+		d.resultsMux.Lock()
+		if latest := d.latest; latest != nil && latest.seq >= trigger.seq {
+			d.resultsMux.Unlock()
+
+			d.logger.WithField("data", latest.value.String()).Debugln("Run result received in", time.Since(ts))
+			return new(big.Int).Set(latest.value), nil
+		} else if err := trigger.err; err != nil {
+			d.resultsMux.Unlock()
+			return nil, err
+		}
+		updatedC := d.updatedC
+		d.resultsMux.Unlock()
+
+		select {
+		case <-updatedC:
+		case <-ctx.Done():
+			// the result, if any, won't answer this Observe anymore
+			d.resultsMux.Lock()
+			d.removeTrigger(trigger.seq)
+			d.resultsMux.Unlock()
+
+			return nil, ErrObserveTimeout
+		}
+	}
+}
+
+// Run matches the result to the oldest pending trigger and caches it, dropping
+// the expired triggers first. Late and unsolicited results are reported, but cached as well.
+func (d *dsWebhook) Run(result *big.Int) error {
+	now := time.Now()
+
+	d.resultsMux.Lock()
+	defer d.resultsMux.Unlock()
+
+	d.expireTriggers(now)
+
+	runResult := &webhookResult{
+		value:      result,
+		receivedAt: now,
+	}
+
+	if len(d.pending) == 0 {
+		// doesn't answer any trigger, including the future ones
+		runResult.seq = d.nextSeq - 1
+
+		metrics.ReportClosureFuncStatus("webhook_result_unsolicited", d.svcTags)
+		d.logger.WithField("data", result.String()).Warningln("received run result without a pending trigger")
+	} else {
+		runResult.seq = d.pending[0].seq
+		d.pending = d.pending[1:]
+
+		if len(d.pending) > 0 {
+			metrics.ReportClosureFuncStatus("webhook_result_late", d.svcTags)
+			d.logger.WithField("data", result.String()).Warningln("received late run result for a previous trigger")
+		}
+	}
+
+	if d.latest != nil && d.latest.seq > runResult.seq {
+		runResult.seq = d.latest.seq
+	}
+
+	d.latest = runResult
+	d.notifyUpdated()
+
+	return nil
+}
+
+// notifyUpdated wakes up Observe calls waiting for results, must be called under resultsMux.
+func (d *dsWebhook) notifyUpdated() {
+	close(d.updatedC)
+	d.updatedC = make(chan struct{})
+}
+
+func (d *dsWebhook) addTrigger(ctx context.Context, ts time.Time) *webhookTrigger {
+	d.expireTriggers(ts)
+
+	// a result is useless once the Observe gave up
+	expiresAt := ts.Add(defaultTriggerTTL)
+	if deadline, ok := ctx.Deadline(); ok {
+		expiresAt = deadline
+	}
+
+	trigger := &webhookTrigger{
+		seq:       d.nextSeq,
+		expiresAt: expiresAt,
+	}
+	d.nextSeq++
+
+	d.pending = append(d.pending, trigger)
+	if len(d.pending) > maxPendingTriggers {
+		d.pending = d.pending[len(d.pending)-maxPendingTriggers:]
+	}
+
+	return trigger
+}
+
+func (d *dsWebhook) removeTrigger(seq uint64) {
+	for idx, trigger := range d.pending {
+		if trigger.seq == seq {
+			d.pending = append(d.pending[:idx], d.pending[idx+1:]...)
+			return
+		}
+	}
+}
+
+// expireTriggers drops all triggers past their deadline, those may be out of order
+// if Observe calls have different deadlines.
+func (d *dsWebhook) expireTriggers(now time.Time) {
+	pending := d.pending[:0]
+	for _, trigger := range d.pending {
+		if now.After(trigger.expiresAt) {
+			metrics.ReportClosureFuncStatus("webhook_trigger_expired", d.svcTags)
+			continue
+		}
+
+		pending = append(pending, trigger)
+	}
+
+	d.pending = pending
+}
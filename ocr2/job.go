@@ -2,27 +2,27 @@ package ocr2
 
 import (
 	"context"
+	"io"
+	"math"
 	"math/big"
 	"sync"
 	"time"
 
-	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
-	ocrcore "github.com/smartcontractkit/chainlink/core/services/offchainreporting2"
 	"github.com/smartcontractkit/libocr/commontypes"
 	ocr2 "github.com/smartcontractkit/libocr/offchainreporting2"
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
 
-	"github.com/InjectiveLabs/chainlink-injective/chainlink"
 	"github.com/InjectiveLabs/chainlink-injective/db"
 	"github.com/InjectiveLabs/chainlink-injective/db/model"
 	"github.com/InjectiveLabs/chainlink-injective/injective/median_report"
 	"github.com/InjectiveLabs/chainlink-injective/keys/ocrkey"
 	"github.com/InjectiveLabs/chainlink-injective/keys/p2pkey"
 	"github.com/InjectiveLabs/chainlink-injective/logging"
+	"github.com/InjectiveLabs/chainlink-injective/metrics"
 	"github.com/InjectiveLabs/chainlink-injective/p2p"
 )
 
@@ -32,20 +32,20 @@ type Job interface {
 	Start() error
 	Run(data string) error
 	Stop() error
+	Status(ctx context.Context) *model.JobStatus
 }
 
 var _ Job = &job{}
 
 type job struct {
-	dbSvc    db.DBService
 	dbJobSvc db.JobDBService
-	dbGorm   db.ExternalGorm
 	stateDB  JobStateDB
 
 	jobID   string
 	jobSpec *model.JobSpec
 
-	client                 chainlink.WebhookClient
+	dataSource             median.DataSource
+	juelsPerFeeCoinSource  median.DataSource
 	transmitter            ocrtypes.ContractTransmitter
 	medianReporter         median.MedianContract
 	onchainKeyring         ocrtypes.OnchainKeyring
@@ -55,7 +55,8 @@ type job struct {
 	svc    ocr2Service
 	p2pSvc p2pService
 
-	runData chan *big.Int
+	status     *jobStatus
+	metricTags metrics.Tags
 
 	runningMux *sync.RWMutex
 	running    bool
@@ -78,25 +79,50 @@ type p2pService interface {
 func (s *jobService) newJob(
 	jobID string,
 	jobSpec *model.JobSpec,
-	dbDriver DBDriver,
+	storage db.Storage,
 	transmitter ocrtypes.ContractTransmitter,
 	medianReporter median.MedianContract,
 	onchainKeyring ocrtypes.OnchainKeyring,
 	configTracker ocrtypes.ContractConfigTracker,
 	offchainConfigDigester ocrtypes.OffchainConfigDigester,
+	status *jobStatus,
 ) (Job, error) {
+	dataSource, err := newJobDataSource(jobID, jobSpec, s.client)
+	if err != nil {
+		err = errors.Wrap(err, "failed to init job data source")
+		return nil, err
+	}
+
+	juelsPerFeeCoinSource, err := newJuelsPerFeeCoinDataSource(jobID, jobSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	metricTags := metrics.Tags{
+		"job":  jobID,
+		"feed": string(jobSpec.FeedID),
+	}
+
+	medianReporter = &medianContractMetrics{
+		MedianContract: medianReporter,
+		status:         status,
+		metricTags:     metricTags,
+	}
+
 	j := &job{
 		jobID:   jobID,
 		jobSpec: jobSpec,
 
-		client:                 s.client,
+		dataSource:             dataSource,
+		juelsPerFeeCoinSource:  juelsPerFeeCoinSource,
 		transmitter:            transmitter,
 		medianReporter:         medianReporter,
 		onchainKeyring:         onchainKeyring,
 		configTracker:          configTracker,
 		offchainConfigDigester: offchainConfigDigester,
 
-		runData: make(chan *big.Int),
+		status:     status,
+		metricTags: metricTags,
 
 		runningMux: new(sync.RWMutex),
 		logger: log.WithFields(log.Fields{
@@ -105,34 +131,14 @@ func (s *jobService) newJob(
 		}),
 	}
 
-	switch v := dbDriver.(type) {
-	case db.DBService:
-		j.dbSvc = v
-	case db.ExternalGorm:
-		j.dbGorm = v
-	default:
-		err := errors.Errorf("unsupported DB driver: %s", dbDriver.String())
+	dbJobSvc, err := storage.JobDBService(jobID)
+	if err != nil {
+		err = errors.Wrap(err, "failed to init Job DB service")
 		return nil, err
 	}
 
-	if j.dbSvc != nil {
-		dbJobSvc, err := db.NewJobDBService(j.dbSvc.Connection(), jobID)
-		if err != nil {
-			err = errors.Wrap(err, "failed to init Job DB service")
-			return nil, err
-		}
-
-		j.dbJobSvc = dbJobSvc
-		j.stateDB = NewJobDBWrapper(dbJobSvc)
-	} else if j.dbGorm != nil {
-		sqlConn, err := j.dbGorm.Connection()
-		if err != nil {
-			err = errors.Wrap(err, "failed to get SQL connection")
-			return nil, err
-		}
-
-		j.stateDB = ocrcore.NewDB(sqlConn, 1)
-	}
+	j.dbJobSvc = dbJobSvc
+	j.stateDB = NewJobDBWrapper(dbJobSvc)
 
 	if err := j.initOracleService(
 		s.peerKey,
@@ -156,20 +162,7 @@ func (j *job) initOracleService(
 		return errors.New("refusing to start Job with unexpected OCR2 Key")
 	}
 
-	var peerDB p2p.DiscovererDatabase
-
-	if j.dbJobSvc != nil {
-		peerDB = p2p.NewAnnounceDBWrapper(j.dbJobSvc)
-	} else if j.dbGorm != nil {
-		sqlConn, err := j.dbGorm.Connection()
-		if err != nil {
-			err = errors.Wrap(err, "failed to get SQL connection")
-			return err
-		}
-
-		peerID := peerKey.MustGetPeerID()
-		peerDB = ocrcore.NewDiscovererDatabase(sqlConn, peer.ID(peerID))
-	}
+	peerDB := p2p.NewAnnounceDBWrapper(j.dbJobSvc)
 
 	p2pService, err := p2p.NewService(
 		peerKey,
@@ -247,9 +240,11 @@ func (j *job) initOracleService(
 	numericalMedianFactory := median.NumericalMedianFactory{
 		ContractTransmitter:       j.medianReporter,
 		DataSource:                j, // reads from Observe() of this job
-		JuelsPerFeeCoinDataSource: &dsZero{},
+		JuelsPerFeeCoinDataSource: j.juelsPerFeeCoinSource,
 		Logger:                    ocrLogger,
-		ReportCodec:               median_report.ReportCodec{},
+		ReportCodec: median_report.ReportCodec{
+			IncludeJuelsPerFeeCoin: ocrConfig.ReportJuelsPerFeeCoin,
+		},
 	}
 
 	ocrArgs := ocr2.OracleArgs{
@@ -285,8 +280,15 @@ func (j *job) Start() (err error) {
 		defer j.runningMux.Unlock()
 		j.running = true
 
-		if err := j.svc.Start(); err != nil {
-			err = errors.Wrap(err, "failed to start OCR2 service")
+		if tracker, ok := j.configTracker.(ocr2Service); ok {
+			if trackerErr := tracker.Start(); trackerErr != nil {
+				j.logger.WithError(trackerErr).Warningln("failed to start config tracker, relying on polling")
+			}
+		}
+
+		if startErr := j.svc.Start(); startErr != nil {
+			err = errors.Wrap(startErr, "failed to start OCR2 service")
+			j.status.recordError(err)
 		}
 	})
 
@@ -304,6 +306,12 @@ func (j *job) Run(data string) error {
 		return err
 	}
 
+	runnable, ok := j.dataSource.(runnableDataSource)
+	if !ok {
+		j.logger.WithError(ErrRunNotSupported).Warningln("failed to run job")
+		return ErrRunNotSupported
+	}
+
 	observedValue, ok := new(big.Int).SetString(data, 10)
 	if !ok {
 		err := errors.Errorf("failed to parse job input %s as big.Int", data)
@@ -311,16 +319,11 @@ func (j *job) Run(data string) error {
 		return err
 	}
 
-	select {
-	case j.runData <- observedValue:
-	default:
-	}
-
-	return nil
+	return runnable.Run(observedValue)
 }
 
 func (j *job) Stop() (err error) {
-	j.onceStart.Do(func() {
+	j.onceStop.Do(func() {
 		j.logger.Infoln("Stopping OCR2 Job")
 
 		j.runningMux.Lock()
@@ -335,14 +338,51 @@ func (j *job) Stop() (err error) {
 			j.logger.WithError(err).Warningln("failed to stop P2P service")
 		}
 
-		if err := j.svc.Close(); err != nil {
-			err = errors.Wrap(err, "failed to stop OCR2 service")
+		if tracker, ok := j.configTracker.(ocr2Service); ok {
+			if trackerErr := tracker.Close(); trackerErr != nil {
+				j.logger.WithError(trackerErr).Warningln("failed to stop config tracker")
+			}
+		}
+
+		if closeErr := j.svc.Close(); closeErr != nil {
+			err = errors.Wrap(closeErr, "failed to stop OCR2 service")
+		}
+
+		// after OCR2 service is stopped, so no more Txs are tracked
+		if transmitter, ok := j.transmitter.(io.Closer); ok {
+			if closeErr := transmitter.Close(); closeErr != nil {
+				j.logger.WithError(closeErr).Warningln("failed to stop transmitter")
+			}
 		}
 	})
 
 	return err
 }
 
+// Status returns a snapshot of the job state. The config digest is queried
+// from the chain, so it's left empty if the query fails.
+func (j *job) Status(ctx context.Context) *model.JobStatus {
+	j.runningMux.RLock()
+	running := j.running
+	j.runningMux.RUnlock()
+
+	status := &model.JobStatus{
+		JobID:     model.ID(j.jobID),
+		Spec:      redactJobSpec(j.jobSpec),
+		IsRunning: running,
+	}
+
+	j.status.fill(status)
+
+	if _, configDigest, err := j.configTracker.LatestConfigDetails(ctx); err != nil {
+		j.logger.WithError(err).Warningln("failed to get latest config digest")
+	} else if configDigest != (ocrtypes.ConfigDigest{}) {
+		status.ConfigDigest = model.ID(configDigest.Hex())
+	}
+
+	return status
+}
+
 func (j *job) StateDB() JobStateDB {
 	return j.stateDB
 }
@@ -372,24 +412,62 @@ func (j *job) Observe(ctx context.Context) (*big.Int, error) {
 	j.logger.Infoln("Observe triggered")
 	ts := time.Now()
 
-	go func() {
-		if err := j.client.TriggerJob(j.jobID); err != nil {
-			j.logger.WithError(err).Errorln("failed to trigger Job on the Chainlink node")
-		}
-	}()
+	metrics.ReportObservationAttempted(j.metricTags)
 
-	select {
-	case result, ok := <-j.runData:
-		if !ok {
-			j.logger.Warningln("Observe exits due to Job shutdown")
-			return nil, ErrJobStopped
+	result, err := j.dataSource.Observe(ctx)
+	if err != nil {
+		if err == ErrObserveTimeout || ctx.Err() != nil {
+			metrics.ReportObservationTimedOut(j.metricTags)
+			j.logger.WithError(ctx.Err()).Warningln("Observation timed out in", time.Since(ts))
+			j.status.recordError(ErrObserveTimeout)
+			return nil, ErrObserveTimeout
 		}
 
-		j.logger.WithField("data", result.String()).Infoln("Observation received in", time.Since(ts))
-		return result, nil
+		metrics.ReportObservationFailed(j.metricTags)
+		j.logger.WithError(err).Warningln("Observation failed in", time.Since(ts))
+		j.status.recordError(err)
+		return nil, err
+	}
+
+	metrics.ReportObservationSucceeded(time.Since(ts), j.metricTags)
+	j.status.recordObservation(result)
+	j.logger.WithField("data", result.String()).Infoln("Observation received in", time.Since(ts))
+	return result, nil
+}
+
+var _ median.MedianContract = &medianContractMetrics{}
 
-	case <-ctx.Done():
-		j.logger.WithError(ctx.Err()).Warningln("Observation timed out in", time.Since(ts))
-		return nil, ErrObserveTimeout
+// medianContractMetrics reports the state of the latest on-chain transmission,
+// as seen by the OCR2 median plugin, along with the deviation of our last observation.
+type medianContractMetrics struct {
+	median.MedianContract
+
+	status     *jobStatus
+	metricTags metrics.Tags
+}
+
+func (m *medianContractMetrics) LatestTransmissionDetails(
+	ctx context.Context,
+) (
+	configDigest ocrtypes.ConfigDigest,
+	epoch uint32,
+	round uint8,
+	latestAnswer *big.Int,
+	latestTimestamp time.Time,
+	err error,
+) {
+	configDigest, epoch, round, latestAnswer, latestTimestamp, err = m.MedianContract.LatestTransmissionDetails(ctx)
+	if err != nil || latestTimestamp.IsZero() {
+		return
 	}
+
+	metrics.ReportLatestTransmission(epoch, round, time.Since(latestTimestamp), m.metricTags)
+
+	if lastObservation := m.status.lastObservationValue(); lastObservation != nil && latestAnswer != nil && latestAnswer.Sign() != 0 {
+		diff := new(big.Float).SetInt(new(big.Int).Sub(lastObservation, latestAnswer))
+		deviation, _ := new(big.Float).Quo(diff, new(big.Float).SetInt(latestAnswer)).Float64()
+		metrics.ReportObservationDeviation(math.Abs(deviation), m.metricTags)
+	}
+
+	return
 }
@@ -0,0 +1,227 @@
+package ocr2
+
+import (
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	"github.com/InjectiveLabs/chainlink-injective/injective"
+)
+
+// jobStatus records the recent activity of a job for introspection.
+type jobStatus struct {
+	mux *sync.RWMutex
+
+	lastObservation   *big.Int
+	lastObservationAt time.Time
+
+	lastTxHash        string
+	lastTransmittedAt time.Time
+
+	lastError   error
+	lastErrorAt time.Time
+
+	membershipErr error
+
+	transmissions []*model.TransmissionRecord
+}
+
+// transmissionHistorySize limits the number of recent transmissions kept per job.
+const transmissionHistorySize = 50
+
+func newJobStatus() *jobStatus {
+	return &jobStatus{
+		mux: new(sync.RWMutex),
+	}
+}
+
+func (s *jobStatus) recordObservation(value *big.Int) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.lastObservation = new(big.Int).Set(value)
+	s.lastObservationAt = time.Now()
+}
+
+func (s *jobStatus) lastObservationValue() *big.Int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	if s.lastObservation == nil {
+		return nil
+	}
+
+	return new(big.Int).Set(s.lastObservation)
+}
+
+// recordTransmission is called by the transmitter after each transmission attempt.
+func (s *jobStatus) recordTransmission(reportCtx types.ReportContext, txHash string, err error) {
+	if err != nil {
+		s.recordError(err)
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.lastTxHash = txHash
+	s.lastTransmittedAt = time.Now()
+}
+
+// recordConfirmation is called by the confirmation tracker with the final result of a transmission Tx.
+func (s *jobStatus) recordConfirmation(conf *injective.TxConfirmation) {
+	record := &model.TransmissionRecord{
+		TxHash:       conf.TxHash,
+		ConfigDigest: model.ID(conf.ConfigDigest.Hex()),
+		Epoch:        conf.Epoch,
+		Round:        conf.Round,
+		Status:       string(conf.Status),
+		Height:       conf.Height,
+		Code:         conf.Code,
+		Log:          conf.Log,
+		TxGasWanted:  conf.GasWanted,
+		TxGasUsed:    conf.GasUsed,
+		TxNumMsgs:    conf.NumMsgs,
+		SentAt:       conf.SentAt.UTC(),
+	}
+
+	if !conf.Fees.Empty() {
+		record.TxFees = conf.Fees.String()
+	}
+
+	if !conf.ConfirmedAt.IsZero() {
+		confirmedAt := conf.ConfirmedAt.UTC()
+		record.ConfirmedAt = &confirmedAt
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.transmissions = append(s.transmissions, record)
+	if len(s.transmissions) > transmissionHistorySize {
+		s.transmissions = s.transmissions[len(s.transmissions)-transmissionHistorySize:]
+	}
+}
+
+func (s *jobStatus) recordError(err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.lastError = err
+	s.lastErrorAt = time.Now()
+}
+
+// recordMembership is called at job start, if the node is not a valid member of the feed
+// or the membership couldn't be verified.
+func (s *jobStatus) recordMembership(err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.membershipErr = err
+}
+
+// fill copies the recorded activity into the status snapshot.
+func (s *jobStatus) fill(status *model.JobStatus) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	if s.lastObservation != nil {
+		lastObservationAt := s.lastObservationAt.UTC()
+		status.LastObservation = s.lastObservation.String()
+		status.LastObservationAt = &lastObservationAt
+	}
+
+	if len(s.lastTxHash) > 0 {
+		lastTransmittedAt := s.lastTransmittedAt.UTC()
+		status.LastTransmissionTxHash = s.lastTxHash
+		status.LastTransmissionAt = &lastTransmittedAt
+	}
+
+	if s.lastError != nil {
+		lastErrorAt := s.lastErrorAt.UTC()
+		status.LastError = s.lastError.Error()
+		status.LastErrorAt = &lastErrorAt
+	}
+
+	if s.membershipErr != nil {
+		status.MembershipError = s.membershipErr.Error()
+	}
+
+	status.Transmissions = make([]*model.TransmissionRecord, 0, len(s.transmissions))
+	for i := len(s.transmissions) - 1; i >= 0; i-- {
+		record := *s.transmissions[i]
+		status.Transmissions = append(status.Transmissions, &record)
+	}
+}
+
+// redactedValue replaces secrets of data source specs in job statuses.
+const redactedValue = "REDACTED"
+
+// redactJobSpec copies the spec for job statuses, with values of data source HTTP headers,
+// URL query params and passwords replaced, since those usually carry API keys.
+func redactJobSpec(spec *model.JobSpec) *model.JobSpec {
+	if spec == nil {
+		return nil
+	}
+
+	redacted := *spec
+	redacted.DataSource = redactDataSourceSpec(spec.DataSource)
+	redacted.JuelsPerFeeCoinSource = redactDataSourceSpec(spec.JuelsPerFeeCoinSource)
+
+	if len(spec.DataSources) > 0 {
+		redacted.DataSources = make([]*model.DataSourceSpec, 0, len(spec.DataSources))
+		for _, dsSpec := range spec.DataSources {
+			redacted.DataSources = append(redacted.DataSources, redactDataSourceSpec(dsSpec))
+		}
+	}
+
+	return &redacted
+}
+
+func redactDataSourceSpec(spec *model.DataSourceSpec) *model.DataSourceSpec {
+	if spec == nil {
+		return nil
+	}
+
+	redacted := *spec
+
+	if len(spec.Headers) > 0 {
+		redacted.Headers = make(map[string]string, len(spec.Headers))
+		for name := range spec.Headers {
+			redacted.Headers[name] = redactedValue
+		}
+	}
+
+	if len(spec.URL) > 0 {
+		redacted.URL = redactURL(spec.URL)
+	}
+
+	return &redacted
+}
+
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		// might be malformed around a secret, so it's not exposed at all
+		return redactedValue
+	}
+
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redactedValue)
+	}
+
+	if len(u.RawQuery) > 0 {
+		query := u.Query()
+		for name := range query {
+			query.Set(name, redactedValue)
+		}
+
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String()
+}
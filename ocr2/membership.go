@@ -0,0 +1,54 @@
+package ocr2
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/chainlink-injective/db/model"
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+	ocrconfig "github.com/InjectiveLabs/chainlink-injective/ocr2/config"
+)
+
+const membershipCheckTimeout = 10 * time.Second
+
+var ErrFeedNotConfigured = errors.New("feed is not configured on chain yet")
+
+// verifyMembership cross-checks identities of this node against the current feed config:
+// the signer, the assigned transmitter, the offchain public key and the peer ID must all be
+// listed at the same oracle index. Returns membershipErr if the node is not a valid member,
+// or checkErr if the check couldn't be done, e.g. the feed isn't configured yet.
+func (j *jobService) verifyMembership(
+	jobSpec *model.JobSpec,
+	transmitterAccount *TransmitterAccount,
+) (membershipErr, checkErr error) {
+	if jobSpec.IsBootstrapPeer {
+		// bootstrap nodes are not listed in the feed config
+		return nil, nil
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), membershipCheckTimeout)
+	defer cancelFn()
+
+	resp, err := j.chainQueryClient.FeedConfig(ctx, &chaintypes.QueryFeedConfigRequest{
+		FeedId: string(jobSpec.FeedID),
+	})
+	if err != nil {
+		checkErr = errors.Wrap(err, "failed to query feed config")
+		return nil, checkErr
+	} else if resp.FeedConfig == nil {
+		return nil, ErrFeedNotConfigured
+	}
+
+	local := j.localOracle()
+	local.Transmitters = []string{transmitterAccount.Address.String()}
+
+	info, err := ocrconfig.InspectFeedConfig(resp.FeedConfig, local)
+	if err != nil {
+		checkErr = errors.Wrap(err, "failed to decode feed config")
+		return nil, checkErr
+	}
+
+	return info.Membership.Verify(), nil
+}
@@ -0,0 +1,18 @@
+package ocr2
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/xlab/suplog"
+)
+
+func TestOCR2(t *testing.T) {
+	if !testing.Verbose() {
+		log.DefaultLogger.SetLevel(log.FatalLevel)
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OCR2 Job Service Test Suite")
+}
@@ -2,13 +2,14 @@ package ocr2
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
-	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -20,6 +21,7 @@ import (
 	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
 	"github.com/InjectiveLabs/chainlink-injective/keys/ocrkey"
 	"github.com/InjectiveLabs/chainlink-injective/keys/p2pkey"
+	ocrconfig "github.com/InjectiveLabs/chainlink-injective/ocr2/config"
 	"github.com/InjectiveLabs/chainlink-injective/p2p"
 )
 
@@ -27,6 +29,9 @@ type JobService interface {
 	StartJob(jobID string, spec *model.JobSpec) error
 	RunJob(jobID, result string) error
 	StopJob(jobID string) error
+	ListJobs(ctx context.Context) []*model.JobStatus
+	GetJob(ctx context.Context, jobID string) (*model.JobStatus, bool)
+	InspectFeedConfig(ctx context.Context, feedID string) (*ocrconfig.FeedConfigInfo, error)
 	Close() error
 }
 
@@ -36,8 +41,7 @@ type JobDB interface {
 }
 
 type jobService struct {
-	dbSvc  db.DBService
-	dbGorm db.ExternalGorm
+	storage db.Storage
 
 	client chainlink.WebhookClient
 
@@ -48,8 +52,10 @@ type jobService struct {
 
 	chainID          string
 	chainQueryClient chaintypes.QueryClient
-	cosmosClient     chainclient.CosmosClient
+	transmitters     *TransmitterPool
+	txDecoder        sdk.TxDecoder
 	tmClient         tmclient.TendermintClient
+	configEvents     *injective.ConfigEventsHub
 	onchainSigner    sdk.AccAddress
 	cosmosKeyring    keyring.Keyring
 
@@ -64,24 +70,23 @@ type jobService struct {
 	logger log.Logger
 }
 
-type DBDriver interface {
-	String() string
-}
-
 func NewJobService(
-	dbDriver DBDriver,
+	storage db.Storage,
 	client chainlink.WebhookClient,
 	peerKey p2pkey.Key,
 	peerNetworkingConfig p2p.NetworkingConfig,
 	ocrKey ocrkey.KeyV2,
 	chainID string,
 	chainQueryClient chaintypes.QueryClient,
-	cosmosClient chainclient.CosmosClient,
+	transmitters *TransmitterPool,
+	txDecoder sdk.TxDecoder,
 	tmClient tmclient.TendermintClient,
 	onchainSigner sdk.AccAddress,
 	cosmosKeyring keyring.Keyring,
+	reportJuelsPerFeeCoin bool,
 ) (JobService, error) {
 	j := &jobService{
+		storage:              storage,
 		client:               client,
 		peerKey:              peerKey,
 		peerNetworkingConfig: peerNetworkingConfig,
@@ -91,14 +96,19 @@ func NewJobService(
 			ContractPollInterval:               15 * time.Second,
 			ContractTransmitterTransmitTimeout: 10 * time.Second,
 			DatabaseTimeout:                    10 * time.Second,
+			ReportJuelsPerFeeCoin:              reportJuelsPerFeeCoin,
 		},
 
 		chainID:          chainID,
 		chainQueryClient: chainQueryClient,
-		cosmosClient:     cosmosClient,
+		transmitters:     transmitters,
+		txDecoder:        txDecoder,
 		tmClient:         tmClient,
-		onchainSigner:    onchainSigner,
-		cosmosKeyring:    cosmosKeyring,
+		configEvents: &injective.ConfigEventsHub{
+			TendermintClient: tmClient,
+		},
+		onchainSigner: onchainSigner,
+		cosmosKeyring: cosmosKeyring,
 
 		activeJobsMux: new(sync.RWMutex),
 		activeJobs:    make(map[string]Job),
@@ -109,13 +119,8 @@ func NewJobService(
 		}),
 	}
 
-	switch v := dbDriver.(type) {
-	case db.DBService:
-		j.dbSvc = v
-	case db.ExternalGorm:
-		j.dbGorm = v
-	default:
-		err := errors.Errorf("unsupported DB driver: %s", dbDriver.String())
+	if err := j.configEvents.Start(); err != nil {
+		err = errors.Wrap(err, "failed to start config events subscription")
 		return nil, err
 	}
 
@@ -126,10 +131,15 @@ func NewJobService(
 	return j, nil
 }
 
+const transmitterAssignTimeout = 10 * time.Second
+
 type Config struct {
 	ContractPollInterval               time.Duration
 	ContractTransmitterTransmitTimeout time.Duration
 	DatabaseTimeout                    time.Duration
+
+	// ReportJuelsPerFeeCoin carries JuelsPerFeeCoin in reports, if the chain supports it
+	ReportJuelsPerFeeCoin bool
 }
 
 // restartExistingJobs revives jobs upon service start. Not thread
@@ -137,20 +147,11 @@ func (j *jobService) restartExistingJobs() (err error) {
 	dbCtx, cancelFn := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancelFn()
 
-	var jobs []*model.Job
-
-	if j.dbSvc != nil {
-		jobs, err = j.dbSvc.ListJobs(dbCtx, &model.Cursor{
-			Limit: 10000,
-		})
-		if err != nil {
-			return err
-		}
-	} else if j.dbGorm != nil {
-		jobs, err = j.dbGorm.LoadJobs(dbCtx)
-		if err != nil {
-			return err
-		}
+	jobs, err := j.storage.ListJobs(dbCtx, &model.Cursor{
+		Limit: 10000,
+	})
+	if err != nil {
+		return err
 	}
 
 	for _, job := range jobs {
@@ -159,7 +160,17 @@ func (j *jobService) restartExistingJobs() (err error) {
 			continue
 		}
 
-		if err := j.ocrStartForJob(string(job.JobID), job.Spec); err != nil {
+		transmitterAccount, err := j.assignTransmitter(job.Spec)
+		if err != nil {
+			j.logger.WithError(err).WithField("jobID", job.JobID).Warningln("failed to start OCR for Job")
+			continue
+		}
+
+		// jobs restored from DB are started anyway, as the feed config may change later
+		membershipErr, checkErr := j.verifyMembership(job.Spec, transmitterAccount)
+
+		err = j.ocrStartForJob(string(job.JobID), job.Spec, transmitterAccount, membershipErr, checkErr)
+		if err != nil {
 			j.logger.WithError(err).WithField("jobID", job.JobID).Warningln("failed to start OCR for Job")
 		}
 	}
@@ -174,11 +185,37 @@ func (j *jobService) restartExistingJobs() (err error) {
 }
 
 func (j *jobService) StartJob(jobID string, jobSpec *model.JobSpec) error {
+	if j.isJobActive(jobID) {
+		return ErrJobAlreadyRunning
+	}
+
+	if _, err := newJobDataSource(jobID, jobSpec, j.client); err != nil {
+		err = errors.Wrap(err, "invalid job data source spec")
+		return err
+	}
+
+	if _, err := newJuelsPerFeeCoinDataSource(jobID, jobSpec); err != nil {
+		err = errors.Wrap(err, "invalid job JuelsPerFeeCoin source spec")
+		return err
+	}
+
+	transmitterAccount, err := j.assignTransmitter(jobSpec)
+	if err != nil {
+		return err
+	}
+
+	// the chain is queried before taking the lock, so other jobs aren't blocked meanwhile
+	membershipErr, checkErr := j.verifyMembership(jobSpec, transmitterAccount)
+	if membershipErr != nil {
+		err = errors.Wrap(membershipErr, "refusing to start Job")
+		return err
+	}
+
 	j.activeJobsMux.Lock()
 	defer j.activeJobsMux.Unlock()
 
 	if _, ok := j.activeJobs[jobID]; ok {
-		return errors.New("job with the same ID already running")
+		return ErrJobAlreadyRunning
 	}
 
 	dbCtx, cancelFn := context.WithTimeout(context.Background(), 30*time.Second)
@@ -191,33 +228,64 @@ func (j *jobService) StartJob(jobID string, jobSpec *model.JobSpec) error {
 		CreatedAt: time.Now().UTC(),
 	}
 
-	if j.dbSvc != nil {
-		if err := j.dbSvc.UpsertJob(dbCtx, newJob); err != nil {
-			j.logger.WithError(err).Warningln("failed to store Job in DB")
-			return ErrInternal
-		}
-	} else if j.dbGorm != nil {
-		if err := j.dbGorm.CreateJob(dbCtx, newJob); err != nil {
-			j.logger.WithError(err).Warningln("failed to store Job in DB")
-			return ErrInternal
-		}
+	if err := j.storage.UpsertJob(dbCtx, newJob); err != nil {
+		j.logger.WithError(err).Warningln("failed to store Job in DB")
+		return ErrInternal
 	}
 
-	return j.ocrStartForJob(jobID, jobSpec)
+	return j.ocrStartForJob(jobID, jobSpec, transmitterAccount, membershipErr, checkErr)
+}
+
+func (j *jobService) isJobActive(jobID string) bool {
+	j.activeJobsMux.RLock()
+	defer j.activeJobsMux.RUnlock()
+
+	_, ok := j.activeJobs[jobID]
+	return ok
 }
 
-func (j *jobService) ocrStartForJob(jobID string, jobSpec *model.JobSpec) (err error) {
-	var dbDriver DBDriver
-	if j.dbSvc != nil {
-		dbDriver = j.dbSvc
-	} else if j.dbGorm != nil {
-		dbDriver = j.dbGorm
+// ocrStartForJob starts OCR with the assigned transmitter account, the results of the feed
+// membership check are recorded into the job status. Must be called with activeJobsMux held,
+// unless the service is starting.
+func (j *jobService) ocrStartForJob(
+	jobID string,
+	jobSpec *model.JobSpec,
+	transmitterAccount *TransmitterAccount,
+	membershipErr error,
+	checkErr error,
+) (err error) {
+	j.logger.WithFields(log.Fields{
+		"jobID":       jobID,
+		"feedId":      jobSpec.FeedID,
+		"transmitter": transmitterAccount.Address.String(),
+	}).Infoln("assigned transmitter account")
+
+	status := newJobStatus()
+
+	if membershipErr != nil {
+		j.logger.WithError(membershipErr).WithField("jobID", jobID).Warningln("⚠️  node won't participate in the feed")
+		status.recordMembership(membershipErr)
+	} else if checkErr != nil {
+		j.logger.WithError(checkErr).WithField("jobID", jobID).Warningln("failed to verify feed membership")
+		status.recordMembership(checkErr)
+	}
+
+	confirmations := &injective.TxConfirmationTracker{
+		JobID:            jobID,
+		FeedId:           string(jobSpec.FeedID),
+		TendermintClient: j.tmClient,
+		TxDecoder:        j.txDecoder,
+		OnConfirmation:   status.recordConfirmation,
 	}
 
 	transmitter := &injective.CosmosModuleTransmitter{
-		FeedId:       string(jobSpec.FeedID),
-		QueryClient:  j.chainQueryClient,
-		CosmosClient: j.cosmosClient,
+		FeedId:        string(jobSpec.FeedID),
+		JobID:         jobID,
+		QueryClient:   j.chainQueryClient,
+		CosmosClient:  transmitterAccount.CosmosClient,
+		TxBroadcaster: transmitterAccount.TxBroadcaster,
+		Confirmations: confirmations,
+		OnTransmit:    status.recordTransmission,
 	}
 
 	onchainKeyring := &injective.InjectiveModuleOnchainKeyring{
@@ -234,6 +302,8 @@ func (j *jobService) ocrStartForJob(jobID string, jobSpec *model.JobSpec) (err e
 		FeedId:           string(jobSpec.FeedID),
 		QueryClient:      j.chainQueryClient,
 		TendermintClient: j.tmClient,
+		ConfigEvents:     j.configEvents,
+		PollInterval:     j.ocrConfig.ContractPollInterval,
 	}
 
 	offchainConfigDigester := &injective.CosmosOffchainConfigDigester{
@@ -244,12 +314,13 @@ func (j *jobService) ocrStartForJob(jobID string, jobSpec *model.JobSpec) (err e
 	job, err := j.newJob(
 		jobID,
 		jobSpec,
-		dbDriver,
+		j.storage,
 		transmitter,
 		medianReporter,
 		onchainKeyring,
 		configTracker,
 		offchainConfigDigester,
+		status,
 	)
 	if err != nil {
 		return err
@@ -261,9 +332,23 @@ func (j *jobService) ocrStartForJob(jobID string, jobSpec *model.JobSpec) (err e
 	return nil
 }
 
+func (j *jobService) assignTransmitter(jobSpec *model.JobSpec) (*TransmitterAccount, error) {
+	assignCtx, cancelFn := context.WithTimeout(context.Background(), transmitterAssignTimeout)
+	defer cancelFn()
+
+	transmitterAccount, err := j.transmitters.Assign(assignCtx, j.chainQueryClient, string(jobSpec.FeedID), jobSpec.TransmitterAddress)
+	if err != nil {
+		err = errors.Wrap(err, "failed to assign transmitter account")
+		return nil, err
+	}
+
+	return transmitterAccount, nil
+}
+
 var (
-	ErrJobNotFound = errors.New("job not found")
-	ErrInternal    = errors.New("internal error")
+	ErrJobNotFound       = errors.New("job not found")
+	ErrJobAlreadyRunning = errors.New("job with the same ID already running")
+	ErrInternal          = errors.New("internal error")
 )
 
 func (j *jobService) RunJob(jobID, result string) error {
@@ -279,6 +364,82 @@ func (j *jobService) RunJob(jobID, result string) error {
 	return activeJob.Run(result)
 }
 
+// jobStatusTimeout limits the time of chain queries for a job status.
+const jobStatusTimeout = 5 * time.Second
+
+// ListJobs returns status snapshots of all active jobs.
+func (j *jobService) ListJobs(ctx context.Context) []*model.JobStatus {
+	j.activeJobsMux.RLock()
+	jobs := make([]Job, 0, len(j.activeJobs))
+	for _, activeJob := range j.activeJobs {
+		jobs = append(jobs, activeJob)
+	}
+	j.activeJobsMux.RUnlock()
+
+	statuses := make([]*model.JobStatus, 0, len(jobs))
+	for _, activeJob := range jobs {
+		statusCtx, cancelFn := context.WithTimeout(ctx, jobStatusTimeout)
+		statuses = append(statuses, activeJob.Status(statusCtx))
+		cancelFn()
+	}
+
+	sort.Slice(statuses, func(i, k int) bool {
+		return statuses[i].JobID < statuses[k].JobID
+	})
+
+	return statuses
+}
+
+// GetJob returns status snapshot of an active job, if found.
+func (j *jobService) GetJob(ctx context.Context, jobID string) (*model.JobStatus, bool) {
+	j.activeJobsMux.RLock()
+	activeJob, ok := j.activeJobs[jobID]
+	j.activeJobsMux.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	statusCtx, cancelFn := context.WithTimeout(ctx, jobStatusTimeout)
+	defer cancelFn()
+
+	return activeJob.Status(statusCtx), true
+}
+
+// InspectFeedConfig queries the feed config and decodes it, checking membership of this node.
+// Returns nil if the feed is not configured.
+func (j *jobService) InspectFeedConfig(ctx context.Context, feedID string) (*ocrconfig.FeedConfigInfo, error) {
+	queryCtx, cancelFn := context.WithTimeout(ctx, jobStatusTimeout)
+	defer cancelFn()
+
+	resp, err := j.chainQueryClient.FeedConfig(queryCtx, &chaintypes.QueryFeedConfigRequest{
+		FeedId: feedID,
+	})
+	if err != nil {
+		err = errors.Wrap(err, "failed to query feed config")
+		return nil, err
+	} else if resp.FeedConfig == nil {
+		return nil, nil
+	}
+
+	return ocrconfig.InspectFeedConfig(resp.FeedConfig, j.localOracle())
+}
+
+// localOracle returns identities of this node, any of the pool accounts may transmit.
+func (j *jobService) localOracle() *ocrconfig.LocalOracle {
+	local := &ocrconfig.LocalOracle{
+		Signer:          j.onchainSigner.String(),
+		PeerID:          peer.ID(j.peerKey.MustGetPeerID()).Pretty(),
+		OffchainKeyring: ocrkey.NewOCR2KeyWrapper(j.ocrKey),
+	}
+
+	for _, acc := range j.transmitters.Accounts() {
+		local.Transmitters = append(local.Transmitters, acc.Address.String())
+	}
+
+	return local
+}
+
 func (j *jobService) StopJob(jobID string) error {
 	j.activeJobsMux.Lock()
 	defer j.activeJobsMux.Unlock()
@@ -292,10 +453,11 @@ func (j *jobService) StopJob(jobID string) error {
 		delete(j.activeJobs, jobID)
 
 		dbCtx, cancelFn := context.WithTimeout(context.Background(), 30*time.Second)
-		if err := j.dbSvc.DeleteJob(dbCtx, model.ID(jobID)); err != nil {
+		defer cancelFn()
+
+		if err := j.storage.DeleteJob(dbCtx, model.ID(jobID)); err != nil {
 			j.logger.WithError(err).Warningln("failed to delete Job from DB")
 		}
-		cancelFn()
 	}()
 
 	return activeJob.Stop()
@@ -321,6 +483,8 @@ func (j *jobService) Close() (err error) {
 
 			delete(j.activeJobs, jobID)
 		}
+
+		_ = j.configEvents.Close()
 	})
 
 	return err
@@ -0,0 +1,163 @@
+package ocr2
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	chainclient "github.com/InjectiveLabs/sdk-go/chain/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/chainlink-injective/injective/txbroadcaster"
+	chaintypes "github.com/InjectiveLabs/chainlink-injective/injective/types"
+)
+
+// TransmitterAccount is a Cosmos account that transmits reports, with its own
+// client and Tx broadcaster, so each account tracks its own sequence.
+type TransmitterAccount struct {
+	Address       sdk.AccAddress
+	CosmosClient  chainclient.CosmosClient
+	TxBroadcaster txbroadcaster.TxBroadcaster
+}
+
+// TransmitterPool assigns transmitter accounts to feeds. An account listed among the feed's
+// on-chain transmitters is always preferred, so FromAccount matches the feed config.
+// Otherwise accounts are assigned to feeds round-robin.
+type TransmitterPool struct {
+	accounts []*TransmitterAccount
+
+	mux         *sync.Mutex
+	next        int
+	assignments map[string]*TransmitterAccount
+
+	logger log.Logger
+}
+
+func NewTransmitterPool(accounts ...*TransmitterAccount) (*TransmitterPool, error) {
+	if len(accounts) == 0 {
+		err := errors.New("transmitter pool must have at least one account")
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(accounts))
+	for _, acc := range accounts {
+		if _, ok := seen[acc.Address.String()]; ok {
+			err := errors.Errorf("duplicate transmitter account %s", acc.Address.String())
+			return nil, err
+		}
+
+		seen[acc.Address.String()] = struct{}{}
+	}
+
+	p := &TransmitterPool{
+		accounts:    accounts,
+		mux:         new(sync.Mutex),
+		assignments: make(map[string]*TransmitterAccount),
+		logger: log.WithFields(log.Fields{
+			"svc": "transmitter_pool",
+		}),
+	}
+
+	return p, nil
+}
+
+// Accounts returns all accounts of the pool.
+func (p *TransmitterPool) Accounts() []*TransmitterAccount {
+	return p.accounts
+}
+
+// Assign picks the transmitter account for the feed. If address is set, only that
+// account is accepted. The assignment sticks for the lifetime of the pool.
+func (p *TransmitterPool) Assign(
+	ctx context.Context,
+	queryClient chaintypes.QueryClient,
+	feedID string,
+	address string,
+) (*TransmitterAccount, error) {
+	onchainTransmitters, err := p.onchainTransmitters(ctx, queryClient, feedID)
+	if err != nil {
+		p.logger.WithError(err).WithField("feedId", feedID).Warningln("failed to query feed transmitters")
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	var acc *TransmitterAccount
+
+	if len(address) > 0 {
+		if acc = p.findAccount(address); acc == nil {
+			err := errors.Errorf("transmitter %s is not in the pool", address)
+			return nil, err
+		}
+
+		if len(onchainTransmitters) > 0 {
+			if _, ok := onchainTransmitters[acc.Address.String()]; !ok {
+				err := errors.Errorf("transmitter %s is not listed among on-chain transmitters of feed %s", address, feedID)
+				return nil, err
+			}
+		}
+	} else {
+		for _, poolAcc := range p.accounts {
+			if _, ok := onchainTransmitters[poolAcc.Address.String()]; ok {
+				acc = poolAcc
+				break
+			}
+		}
+	}
+
+	if acc == nil {
+		if assigned, ok := p.assignments[feedID]; ok {
+			acc = assigned
+		} else {
+			acc = p.accounts[p.next%len(p.accounts)]
+			p.next++
+		}
+
+		if len(onchainTransmitters) > 0 {
+			p.logger.WithFields(log.Fields{
+				"feedId":      feedID,
+				"transmitter": acc.Address.String(),
+			}).Warningln("none of pool accounts is listed among on-chain transmitters of the feed")
+		}
+	}
+
+	p.assignments[feedID] = acc
+
+	return acc, nil
+}
+
+func (p *TransmitterPool) findAccount(address string) *TransmitterAccount {
+	for _, acc := range p.accounts {
+		if acc.Address.String() == address {
+			return acc
+		}
+	}
+
+	return nil
+}
+
+func (p *TransmitterPool) onchainTransmitters(
+	ctx context.Context,
+	queryClient chaintypes.QueryClient,
+	feedID string,
+) (map[string]struct{}, error) {
+	if queryClient == nil {
+		return nil, nil
+	}
+
+	resp, err := queryClient.FeedConfig(ctx, &chaintypes.QueryFeedConfigRequest{
+		FeedId: feedID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transmitters := make(map[string]struct{}, len(resp.GetFeedConfig().GetTransmitters()))
+	for _, transmitter := range resp.GetFeedConfig().GetTransmitters() {
+		transmitters[transmitter] = struct{}{}
+	}
+
+	return transmitters, nil
+}